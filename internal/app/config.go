@@ -0,0 +1,210 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/gamification"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/infrastructure/external"
+)
+
+// loadAuthConfig reads authentication settings from environment variables
+func loadAuthConfig() (auth.Config, error) {
+	verificationTTL, err := getDurationEnv("EMAIL_VERIFICATION_TTL", 24*time.Hour)
+	if err != nil {
+		return auth.Config{}, err
+	}
+
+	resetTTL, err := getDurationEnv("PASSWORD_RESET_TTL", 30*time.Minute)
+	if err != nil {
+		return auth.Config{}, err
+	}
+
+	lockout, err := loadLockoutPolicy()
+	if err != nil {
+		return auth.Config{}, err
+	}
+
+	challengeTTL, err := getDurationEnv("TWO_FACTOR_CHALLENGE_TTL", 5*time.Minute)
+	if err != nil {
+		return auth.Config{}, err
+	}
+
+	issuer := os.Getenv("TWO_FACTOR_ISSUER")
+	if issuer == "" {
+		issuer = "Tutor App"
+	}
+
+	return auth.Config{
+		AppURL:                strings.TrimSuffix(os.Getenv("APP_URL"), "/"),
+		VerificationTokenTTL:  verificationTTL,
+		PasswordResetTokenTTL: resetTTL,
+		Lockout:               lockout,
+		TwoFactorIssuer:       issuer,
+		TwoFactorChallengeTTL: challengeTTL,
+	}, nil
+}
+
+// loadLockoutPolicy reads the login lockout settings, keeping defaults for unset variables
+func loadLockoutPolicy() (auth.LockoutPolicy, error) {
+	policy := auth.DefaultLockoutPolicy()
+	var err error
+
+	if policy.AccountThreshold, err = getIntEnv("LOGIN_LOCKOUT_ACCOUNT_THRESHOLD", policy.AccountThreshold); err != nil {
+		return policy, err
+	}
+	if policy.IPThreshold, err = getIntEnv("LOGIN_LOCKOUT_IP_THRESHOLD", policy.IPThreshold); err != nil {
+		return policy, err
+	}
+	if policy.BaseLockout, err = getDurationEnv("LOGIN_LOCKOUT_BASE", policy.BaseLockout); err != nil {
+		return policy, err
+	}
+	if policy.MaxLockout, err = getDurationEnv("LOGIN_LOCKOUT_MAX", policy.MaxLockout); err != nil {
+		return policy, err
+	}
+	if policy.FailureWindow, err = getDurationEnv("LOGIN_FAILURE_WINDOW", policy.FailureWindow); err != nil {
+		return policy, err
+	}
+	if policy.MaxLockout < policy.BaseLockout {
+		return policy, fmt.Errorf("LOGIN_LOCKOUT_MAX must not be shorter than LOGIN_LOCKOUT_BASE")
+	}
+	return policy, nil
+}
+
+// loadOIDCProviders reads the comma separated OIDC_PROVIDERS and the OIDC_<NAME>_* settings of each
+func loadOIDCProviders() (map[string]auth.OIDCProvider, error) {
+	providers := make(map[string]auth.OIDCProvider)
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		config := external.OIDCConfig{
+			IssuerURL:    os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:       strings.Fields(os.Getenv(prefix + "SCOPES")),
+		}
+		if config.IssuerURL == "" || config.ClientID == "" || config.RedirectURL == "" {
+			return nil, fmt.Errorf("%sISSUER, %sCLIENT_ID and %sREDIRECT_URL are required", prefix, prefix, prefix)
+		}
+		if len(config.Scopes) == 0 {
+			config.Scopes = []string{"email", "profile"}
+		}
+		providers[name] = external.NewOIDCProvider(config)
+	}
+	return providers, nil
+}
+
+// loadBlobStore creates the blob store selected by STORAGE_DRIVER. For the local driver it also
+// returns the directory the server has to serve under /uploads.
+func loadBlobStore(ctx context.Context) (shared.BlobStore, string, error) {
+	publicURL := os.Getenv("STORAGE_PUBLIC_URL")
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "local":
+		dir := os.Getenv("STORAGE_LOCAL_DIR")
+		if dir == "" {
+			dir = "./uploads"
+		}
+		if publicURL == "" {
+			publicURL = "/uploads"
+		}
+		return external.NewLocalBlobStore(dir, publicURL), dir, nil
+	case "s3":
+		store, err := external.NewS3BlobStore(ctx, external.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			Region:    os.Getenv("S3_REGION"),
+			UseSSL:    os.Getenv("S3_USE_SSL") == "true",
+			PublicURL: publicURL,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return store, "", nil
+	default:
+		return nil, "", fmt.Errorf("invalid STORAGE_DRIVER: %q", driver)
+	}
+}
+
+// xpRuleEnvs maps the environment variables overriding XP rules to their events
+var xpRuleEnvs = map[string]shared.EventType{
+	"XP_LESSON_COMPLETED": shared.EventLessonCompleted,
+	"XP_COURSE_COMPLETED": shared.EventCourseCompleted,
+}
+
+// loadGamificationConfig reads the XP rules and level curve, keeping defaults for unset variables.
+// An XP rule of 0 disables the reward of its event.
+func loadGamificationConfig() (gamification.Config, error) {
+	config := gamification.Config{
+		Rules: gamification.DefaultRules(),
+		Curve: gamification.DefaultCurve(),
+	}
+
+	for key, eventType := range xpRuleEnvs {
+		value := os.Getenv(key)
+		if value == "" {
+			continue
+		}
+		points, err := strconv.Atoi(value)
+		if err != nil || points < 0 {
+			return config, fmt.Errorf("invalid %s: %q", key, value)
+		}
+		config.Rules[eventType] = points
+	}
+
+	var err error
+	if config.Curve.BaseXP, err = getIntEnv("LEVEL_BASE_XP", config.Curve.BaseXP); err != nil {
+		return config, err
+	}
+	if value := os.Getenv("LEVEL_GROWTH"); value != "" {
+		growth, err := strconv.ParseFloat(value, 64)
+		if err != nil || growth < 1 {
+			return config, fmt.Errorf("invalid LEVEL_GROWTH: %q, must be at least 1", value)
+		}
+		config.Curve.Growth = growth
+	}
+	return config, nil
+}
+
+// loadVerificationPolicy reads the comma separated features that require a verified email
+func loadVerificationPolicy() shared.VerificationPolicy {
+	return shared.NewVerificationPolicy(strings.Split(os.Getenv("EMAIL_VERIFICATION_REQUIRED_FOR"), ",")...)
+}
+
+// getDurationEnv parses a duration environment variable such as "30m" with a fallback value
+func getDurationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", key, value)
+	}
+	return duration, nil
+}
+
+// getIntEnv parses a positive integer environment variable with a fallback value
+func getIntEnv(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", key, value)
+	}
+	return number, nil
+}
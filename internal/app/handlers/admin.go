@@ -0,0 +1,653 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/achievements"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/gamification"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	web_admin "github.com/IbadT/tutor_app_back.git/internal/web/admin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// AdminHandler handles administration requests
+type AdminHandler struct {
+	authService         auth.Service
+	userService         user.Service
+	gamificationService gamification.Service
+	achievementsService achievements.Service
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(authService auth.Service, userService user.Service, gamificationService gamification.Service, achievementsService achievements.Service) *AdminHandler {
+	return &AdminHandler{
+		authService:         authService,
+		userService:         userService,
+		gamificationService: gamificationService,
+		achievementsService: achievementsService,
+	}
+}
+
+// GetAdminLockouts handles GET /admin/lockouts
+func (h *AdminHandler) GetAdminLockouts(ctx context.Context, request web_admin.GetAdminLockoutsRequestObject) (web_admin.GetAdminLockoutsResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetLockoutsError(shared.ErrUnauthorized)
+	}
+
+	var page, limit int
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	events, err := h.authService.GetLockoutEvents(actorID, page, limit)
+	if err != nil {
+		return h.handleGetLockoutsError(err)
+	}
+
+	responseEvents := make([]web_admin.LockoutEvent, 0, len(events.Events))
+	for i := range events.Events {
+		responseEvents = append(responseEvents, toWebLockoutEvent(&events.Events[i]))
+	}
+
+	return web_admin.GetAdminLockouts200JSONResponse{
+		Events: &responseEvents,
+		Pagination: &web_admin.Pagination{
+			Page:  &events.Page,
+			Limit: &events.Limit,
+			Total: &events.Total,
+		},
+	}, nil
+}
+
+// PutAdminUsersUserIdRole handles PUT /admin/users/{user_id}/role
+func (h *AdminHandler) PutAdminUsersUserIdRole(ctx context.Context, request web_admin.PutAdminUsersUserIdRoleRequestObject) (web_admin.PutAdminUsersUserIdRoleResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleChangeRoleError(shared.ErrUnauthorized)
+	}
+	if request.Body == nil {
+		return h.handleChangeRoleError(shared.ErrMissingFields)
+	}
+
+	changeRequest := &user.ChangeRoleRequest{
+		Role: shared.Role(request.Body.Role),
+	}
+	if request.Body.Reason != nil {
+		changeRequest.Reason = *request.Body.Reason
+	}
+
+	change, err := h.userService.ChangeUserRole(actorID, uuid.UUID(request.UserId), changeRequest)
+	if err != nil {
+		return h.handleChangeRoleError(err)
+	}
+
+	return web_admin.PutAdminUsersUserIdRole200JSONResponse(toWebRoleChange(change)), nil
+}
+
+// GetAdminRoleChanges handles GET /admin/role-changes
+func (h *AdminHandler) GetAdminRoleChanges(ctx context.Context, request web_admin.GetAdminRoleChangesRequestObject) (web_admin.GetAdminRoleChangesResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetRoleChangesError(shared.ErrUnauthorized)
+	}
+
+	var page, limit int
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	changes, err := h.userService.GetRoleChanges(actorID, (*uuid.UUID)(request.Params.UserId), page, limit)
+	if err != nil {
+		return h.handleGetRoleChangesError(err)
+	}
+
+	responseChanges := make([]web_admin.RoleChange, 0, len(changes.Changes))
+	for i := range changes.Changes {
+		responseChanges = append(responseChanges, toWebRoleChange(&changes.Changes[i]))
+	}
+
+	return web_admin.GetAdminRoleChanges200JSONResponse{
+		Changes: &responseChanges,
+		Pagination: &web_admin.Pagination{
+			Page:  &changes.Page,
+			Limit: &changes.Limit,
+			Total: &changes.Total,
+		},
+	}, nil
+}
+
+// PostAdminUsersUserIdXpRecompute handles POST /admin/users/{user_id}/xp/recompute
+func (h *AdminHandler) PostAdminUsersUserIdXpRecompute(ctx context.Context, request web_admin.PostAdminUsersUserIdXpRecomputeRequestObject) (web_admin.PostAdminUsersUserIdXpRecomputeResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleRecomputeXPError(shared.ErrUnauthorized)
+	}
+
+	stats, err := h.gamificationService.Recompute(actorID, uuid.UUID(request.UserId))
+	if err != nil {
+		return h.handleRecomputeXPError(err)
+	}
+
+	return web_admin.PostAdminUsersUserIdXpRecompute200JSONResponse{
+		Id:                (*openapi_types.UUID)(&stats.ID),
+		UserId:            (*openapi_types.UUID)(&stats.UserID),
+		CoursesCompleted:  &stats.CoursesCompleted,
+		CoursesInProgress: &stats.CoursesInProgress,
+		Followers:         &stats.Followers,
+		Following:         &stats.Following,
+		Level:             &stats.Level,
+		Xp:                &stats.XP,
+		NextLevelXp:       &stats.NextLevelXP,
+	}, nil
+}
+
+// GetAdminAchievements handles GET /admin/achievements
+func (h *AdminHandler) GetAdminAchievements(ctx context.Context, request web_admin.GetAdminAchievementsRequestObject) (web_admin.GetAdminAchievementsResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleListAchievementsError(shared.ErrUnauthorized)
+	}
+
+	definitions, err := h.achievementsService.ListDefinitions(actorID, achievements.KindAchievement)
+	if err != nil {
+		return h.handleListAchievementsError(err)
+	}
+
+	response := make([]web_admin.RewardDefinition, 0, len(definitions))
+	for i := range definitions {
+		response = append(response, toWebRewardDefinition(&definitions[i]))
+	}
+	return web_admin.GetAdminAchievements200JSONResponse(response), nil
+}
+
+// PostAdminAchievements handles POST /admin/achievements
+func (h *AdminHandler) PostAdminAchievements(ctx context.Context, request web_admin.PostAdminAchievementsRequestObject) (web_admin.PostAdminAchievementsResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleCreateAchievementError(shared.ErrUnauthorized)
+	}
+	if request.Body == nil {
+		return h.handleCreateAchievementError(shared.ErrMissingFields)
+	}
+
+	definition, err := h.achievementsService.CreateDefinition(actorID, achievements.KindAchievement, toDefinitionRequest(web_admin.RewardDefinitionRequest(*request.Body)))
+	if err != nil {
+		return h.handleCreateAchievementError(err)
+	}
+
+	return web_admin.PostAdminAchievements201JSONResponse(toWebRewardDefinition(definition)), nil
+}
+
+// GetAdminAchievementsDefinitionId handles GET /admin/achievements/{definition_id}
+func (h *AdminHandler) GetAdminAchievementsDefinitionId(ctx context.Context, request web_admin.GetAdminAchievementsDefinitionIdRequestObject) (web_admin.GetAdminAchievementsDefinitionIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetAchievementError(shared.ErrUnauthorized)
+	}
+
+	definition, err := h.achievementsService.GetDefinition(actorID, achievements.KindAchievement, uuid.UUID(request.DefinitionId))
+	if err != nil {
+		return h.handleGetAchievementError(err)
+	}
+
+	return web_admin.GetAdminAchievementsDefinitionId200JSONResponse(toWebRewardDefinition(definition)), nil
+}
+
+// PutAdminAchievementsDefinitionId handles PUT /admin/achievements/{definition_id}
+func (h *AdminHandler) PutAdminAchievementsDefinitionId(ctx context.Context, request web_admin.PutAdminAchievementsDefinitionIdRequestObject) (web_admin.PutAdminAchievementsDefinitionIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateAchievementError(shared.ErrUnauthorized)
+	}
+	if request.Body == nil {
+		return h.handleUpdateAchievementError(shared.ErrMissingFields)
+	}
+
+	definition, err := h.achievementsService.UpdateDefinition(actorID, achievements.KindAchievement, uuid.UUID(request.DefinitionId), toDefinitionRequest(web_admin.RewardDefinitionRequest(*request.Body)))
+	if err != nil {
+		return h.handleUpdateAchievementError(err)
+	}
+
+	return web_admin.PutAdminAchievementsDefinitionId200JSONResponse(toWebRewardDefinition(definition)), nil
+}
+
+// DeleteAdminAchievementsDefinitionId handles DELETE /admin/achievements/{definition_id}
+func (h *AdminHandler) DeleteAdminAchievementsDefinitionId(ctx context.Context, request web_admin.DeleteAdminAchievementsDefinitionIdRequestObject) (web_admin.DeleteAdminAchievementsDefinitionIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleDeleteAchievementError(shared.ErrUnauthorized)
+	}
+
+	if err := h.achievementsService.DeleteDefinition(actorID, achievements.KindAchievement, uuid.UUID(request.DefinitionId)); err != nil {
+		return h.handleDeleteAchievementError(err)
+	}
+
+	return web_admin.DeleteAdminAchievementsDefinitionId200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Achievement deleted successfully"; return &msg }(),
+	}, nil
+}
+
+// GetAdminBadges handles GET /admin/badges
+func (h *AdminHandler) GetAdminBadges(ctx context.Context, request web_admin.GetAdminBadgesRequestObject) (web_admin.GetAdminBadgesResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleListBadgesError(shared.ErrUnauthorized)
+	}
+
+	definitions, err := h.achievementsService.ListDefinitions(actorID, achievements.KindBadge)
+	if err != nil {
+		return h.handleListBadgesError(err)
+	}
+
+	response := make([]web_admin.RewardDefinition, 0, len(definitions))
+	for i := range definitions {
+		response = append(response, toWebRewardDefinition(&definitions[i]))
+	}
+	return web_admin.GetAdminBadges200JSONResponse(response), nil
+}
+
+// PostAdminBadges handles POST /admin/badges
+func (h *AdminHandler) PostAdminBadges(ctx context.Context, request web_admin.PostAdminBadgesRequestObject) (web_admin.PostAdminBadgesResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleCreateBadgeError(shared.ErrUnauthorized)
+	}
+	if request.Body == nil {
+		return h.handleCreateBadgeError(shared.ErrMissingFields)
+	}
+
+	definition, err := h.achievementsService.CreateDefinition(actorID, achievements.KindBadge, toDefinitionRequest(web_admin.RewardDefinitionRequest(*request.Body)))
+	if err != nil {
+		return h.handleCreateBadgeError(err)
+	}
+
+	return web_admin.PostAdminBadges201JSONResponse(toWebRewardDefinition(definition)), nil
+}
+
+// GetAdminBadgesDefinitionId handles GET /admin/badges/{definition_id}
+func (h *AdminHandler) GetAdminBadgesDefinitionId(ctx context.Context, request web_admin.GetAdminBadgesDefinitionIdRequestObject) (web_admin.GetAdminBadgesDefinitionIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetBadgeError(shared.ErrUnauthorized)
+	}
+
+	definition, err := h.achievementsService.GetDefinition(actorID, achievements.KindBadge, uuid.UUID(request.DefinitionId))
+	if err != nil {
+		return h.handleGetBadgeError(err)
+	}
+
+	return web_admin.GetAdminBadgesDefinitionId200JSONResponse(toWebRewardDefinition(definition)), nil
+}
+
+// PutAdminBadgesDefinitionId handles PUT /admin/badges/{definition_id}
+func (h *AdminHandler) PutAdminBadgesDefinitionId(ctx context.Context, request web_admin.PutAdminBadgesDefinitionIdRequestObject) (web_admin.PutAdminBadgesDefinitionIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateBadgeError(shared.ErrUnauthorized)
+	}
+	if request.Body == nil {
+		return h.handleUpdateBadgeError(shared.ErrMissingFields)
+	}
+
+	definition, err := h.achievementsService.UpdateDefinition(actorID, achievements.KindBadge, uuid.UUID(request.DefinitionId), toDefinitionRequest(web_admin.RewardDefinitionRequest(*request.Body)))
+	if err != nil {
+		return h.handleUpdateBadgeError(err)
+	}
+
+	return web_admin.PutAdminBadgesDefinitionId200JSONResponse(toWebRewardDefinition(definition)), nil
+}
+
+// DeleteAdminBadgesDefinitionId handles DELETE /admin/badges/{definition_id}
+func (h *AdminHandler) DeleteAdminBadgesDefinitionId(ctx context.Context, request web_admin.DeleteAdminBadgesDefinitionIdRequestObject) (web_admin.DeleteAdminBadgesDefinitionIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleDeleteBadgeError(shared.ErrUnauthorized)
+	}
+
+	if err := h.achievementsService.DeleteDefinition(actorID, achievements.KindBadge, uuid.UUID(request.DefinitionId)); err != nil {
+		return h.handleDeleteBadgeError(err)
+	}
+
+	return web_admin.DeleteAdminBadgesDefinitionId200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Badge deleted successfully"; return &msg }(),
+	}, nil
+}
+
+// toDefinitionRequest converts a web definition request to the domain format
+func toDefinitionRequest(body web_admin.RewardDefinitionRequest) *achievements.DefinitionRequest {
+	req := &achievements.DefinitionRequest{
+		Name:      body.Name,
+		Metric:    achievements.Metric(body.Metric),
+		Threshold: body.Threshold,
+	}
+	if body.Description != nil {
+		req.Description = *body.Description
+	}
+	if body.Icon != nil {
+		req.Icon = *body.Icon
+	}
+	return req
+}
+
+// toWebRewardDefinition converts a domain achievement or badge definition to the web response format
+func toWebRewardDefinition(definition *achievements.Definition) web_admin.RewardDefinition {
+	metric := web_admin.RewardMetric(definition.Metric)
+	return web_admin.RewardDefinition{
+		Id:          (*openapi_types.UUID)(&definition.ID),
+		Name:        &definition.Name,
+		Description: &definition.Description,
+		Icon:        &definition.Icon,
+		Metric:      &metric,
+		Threshold:   &definition.Threshold,
+		CreatedAt:   &definition.CreatedAt,
+		UpdatedAt:   &definition.UpdatedAt,
+	}
+}
+
+// toWebRoleChange converts a domain role change to the web response format
+func toWebRoleChange(change *user.RoleChange) web_admin.RoleChange {
+	oldRole := string(change.OldRole)
+	newRole := string(change.NewRole)
+	return web_admin.RoleChange{
+		Id:        (*openapi_types.UUID)(&change.ID),
+		UserId:    (*openapi_types.UUID)(&change.UserID),
+		ChangedBy: (*openapi_types.UUID)(&change.ChangedBy),
+		OldRole:   &oldRole,
+		NewRole:   &newRole,
+		Reason:    &change.Reason,
+		CreatedAt: &change.CreatedAt,
+	}
+}
+
+// toWebLockoutEvent converts a domain lockout event to the web response format
+func toWebLockoutEvent(event *auth.LockoutEvent) web_admin.LockoutEvent {
+	scope := web_admin.LockoutEventScope(event.Scope)
+	return web_admin.LockoutEvent{
+		Id:          (*openapi_types.UUID)(&event.ID),
+		Scope:       &scope,
+		Key:         &event.Key,
+		UserId:      (*openapi_types.UUID)(event.UserID),
+		IpAddress:   &event.IPAddress,
+		UserAgent:   &event.UserAgent,
+		Failures:    &event.Failures,
+		LockedUntil: &event.LockedUntil,
+		CreatedAt:   &event.CreatedAt,
+	}
+}
+
+func (h *AdminHandler) handleGetLockoutsError(err error) (web_admin.GetAdminLockoutsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_admin.GetAdminLockouts400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_admin.GetAdminLockouts401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.GetAdminLockouts403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.GetAdminLockouts500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.GetAdminLockouts500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleChangeRoleError(err error) (web_admin.PutAdminUsersUserIdRoleResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_admin.PutAdminUsersUserIdRole400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_admin.PutAdminUsersUserIdRole401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.PutAdminUsersUserIdRole403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.PutAdminUsersUserIdRole404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_admin.PutAdminUsersUserIdRole409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.PutAdminUsersUserIdRole500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.PutAdminUsersUserIdRole500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleGetRoleChangesError(err error) (web_admin.GetAdminRoleChangesResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_admin.GetAdminRoleChanges400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_admin.GetAdminRoleChanges401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.GetAdminRoleChanges403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.GetAdminRoleChanges500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.GetAdminRoleChanges500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleRecomputeXPError(err error) (web_admin.PostAdminUsersUserIdXpRecomputeResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_admin.PostAdminUsersUserIdXpRecompute401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.PostAdminUsersUserIdXpRecompute403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.PostAdminUsersUserIdXpRecompute404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.PostAdminUsersUserIdXpRecompute500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.PostAdminUsersUserIdXpRecompute500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleListAchievementsError(err error) (web_admin.GetAdminAchievementsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_admin.GetAdminAchievements401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.GetAdminAchievements403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.GetAdminAchievements500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.GetAdminAchievements500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleCreateAchievementError(err error) (web_admin.PostAdminAchievementsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_admin.PostAdminAchievements400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_admin.PostAdminAchievements401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.PostAdminAchievements403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_admin.PostAdminAchievements409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.PostAdminAchievements500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.PostAdminAchievements500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleGetAchievementError(err error) (web_admin.GetAdminAchievementsDefinitionIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_admin.GetAdminAchievementsDefinitionId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.GetAdminAchievementsDefinitionId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.GetAdminAchievementsDefinitionId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.GetAdminAchievementsDefinitionId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.GetAdminAchievementsDefinitionId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleUpdateAchievementError(err error) (web_admin.PutAdminAchievementsDefinitionIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_admin.PutAdminAchievementsDefinitionId400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_admin.PutAdminAchievementsDefinitionId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.PutAdminAchievementsDefinitionId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.PutAdminAchievementsDefinitionId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_admin.PutAdminAchievementsDefinitionId409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.PutAdminAchievementsDefinitionId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.PutAdminAchievementsDefinitionId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleDeleteAchievementError(err error) (web_admin.DeleteAdminAchievementsDefinitionIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_admin.DeleteAdminAchievementsDefinitionId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.DeleteAdminAchievementsDefinitionId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.DeleteAdminAchievementsDefinitionId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.DeleteAdminAchievementsDefinitionId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.DeleteAdminAchievementsDefinitionId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleListBadgesError(err error) (web_admin.GetAdminBadgesResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_admin.GetAdminBadges401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.GetAdminBadges403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.GetAdminBadges500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.GetAdminBadges500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleCreateBadgeError(err error) (web_admin.PostAdminBadgesResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_admin.PostAdminBadges400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_admin.PostAdminBadges401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.PostAdminBadges403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_admin.PostAdminBadges409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.PostAdminBadges500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.PostAdminBadges500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleGetBadgeError(err error) (web_admin.GetAdminBadgesDefinitionIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_admin.GetAdminBadgesDefinitionId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.GetAdminBadgesDefinitionId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.GetAdminBadgesDefinitionId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.GetAdminBadgesDefinitionId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.GetAdminBadgesDefinitionId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleUpdateBadgeError(err error) (web_admin.PutAdminBadgesDefinitionIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_admin.PutAdminBadgesDefinitionId400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_admin.PutAdminBadgesDefinitionId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.PutAdminBadgesDefinitionId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.PutAdminBadgesDefinitionId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_admin.PutAdminBadgesDefinitionId409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.PutAdminBadgesDefinitionId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.PutAdminBadgesDefinitionId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *AdminHandler) handleDeleteBadgeError(err error) (web_admin.DeleteAdminBadgesDefinitionIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_admin.DeleteAdminBadgesDefinitionId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_admin.DeleteAdminBadgesDefinitionId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_admin.DeleteAdminBadgesDefinitionId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_admin.DeleteAdminBadgesDefinitionId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_admin.DeleteAdminBadgesDefinitionId500JSONResponse{Code: &code, Message: &msg}, nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	web_auth "github.com/IbadT/tutor_app_back.git/internal/web/auth"
@@ -27,13 +28,89 @@ func (h *AuthHandler) PostAuthLogin(ctx context.Context, request web_auth.PostAu
 		Email:    string(body.Email),
 		Password: body.Password,
 	}
+	loginRequest.IPAddress, loginRequest.UserAgent = middleware.ClientInfoFromContext(ctx)
 
-	response, err := h.authService.Login(loginRequest)
+	result, err := h.authService.Login(loginRequest)
 	if err != nil {
 		return h.handleLoginError(err)
 	}
 
+	// With 2FA enabled the client continues at /auth/2fa/verify
+	if result.Challenge != nil {
+		return web_auth.PostAuthLogin202JSONResponse{
+			ChallengeToken: result.Challenge.ChallengeToken,
+			ExpiresAt:      result.Challenge.ExpiresAt,
+		}, nil
+	}
+
 	return web_auth.PostAuthLogin200JSONResponse{
+		AccessToken:  &result.Tokens.AccessToken,
+		RefreshToken: &result.Tokens.RefreshToken,
+	}, nil
+}
+
+// PostAuthOidcProviderAuthorize handles POST /auth/oidc/{provider}/authorize
+func (h *AuthHandler) PostAuthOidcProviderAuthorize(ctx context.Context, request web_auth.PostAuthOidcProviderAuthorizeRequestObject) (web_auth.PostAuthOidcProviderAuthorizeResponseObject, error) {
+	authorizeRequest := &auth.OIDCAuthorizeRequest{Provider: request.Provider}
+	if request.Body != nil && request.Body.Role != nil {
+		authorizeRequest.Role = shared.Role(*request.Body.Role)
+	}
+
+	authorization, err := h.authService.StartOIDCLogin(ctx, authorizeRequest)
+	if err != nil {
+		return h.handleOIDCAuthorizeError(err)
+	}
+
+	return web_auth.PostAuthOidcProviderAuthorize200JSONResponse{
+		AuthorizationUrl: authorization.AuthorizationURL,
+		State:            authorization.State,
+	}, nil
+}
+
+// PostAuthOidcProviderCallback handles POST /auth/oidc/{provider}/callback
+func (h *AuthHandler) PostAuthOidcProviderCallback(ctx context.Context, request web_auth.PostAuthOidcProviderCallbackRequestObject) (web_auth.PostAuthOidcProviderCallbackResponseObject, error) {
+	body := request.Body
+	callbackRequest := &auth.OIDCCallbackRequest{
+		Provider: request.Provider,
+		Code:     body.Code,
+		State:    body.State,
+	}
+	callbackRequest.IPAddress, callbackRequest.UserAgent = middleware.ClientInfoFromContext(ctx)
+
+	result, err := h.authService.CompleteOIDCLogin(ctx, callbackRequest)
+	if err != nil {
+		return h.handleOIDCCallbackError(err)
+	}
+
+	// With 2FA enabled the client continues at /auth/2fa/verify
+	if result.Challenge != nil {
+		return web_auth.PostAuthOidcProviderCallback202JSONResponse{
+			ChallengeToken: result.Challenge.ChallengeToken,
+			ExpiresAt:      result.Challenge.ExpiresAt,
+		}, nil
+	}
+
+	return web_auth.PostAuthOidcProviderCallback200JSONResponse{
+		AccessToken:  &result.Tokens.AccessToken,
+		RefreshToken: &result.Tokens.RefreshToken,
+	}, nil
+}
+
+// PostAuth2faVerify handles POST /auth/2fa/verify
+func (h *AuthHandler) PostAuth2faVerify(ctx context.Context, request web_auth.PostAuth2faVerifyRequestObject) (web_auth.PostAuth2faVerifyResponseObject, error) {
+	body := request.Body
+	verifyRequest := &auth.VerifyTwoFactorRequest{
+		ChallengeToken: body.ChallengeToken,
+		Code:           body.Code,
+	}
+	verifyRequest.IPAddress, verifyRequest.UserAgent = middleware.ClientInfoFromContext(ctx)
+
+	response, err := h.authService.VerifyTwoFactor(verifyRequest)
+	if err != nil {
+		return h.handleVerifyTwoFactorError(err)
+	}
+
+	return web_auth.PostAuth2faVerify200JSONResponse{
 		AccessToken:  &response.AccessToken,
 		RefreshToken: &response.RefreshToken,
 	}, nil
@@ -47,9 +124,10 @@ func (h *AuthHandler) PostAuthRegister(ctx context.Context, request web_auth.Pos
 		LastName:  string(body.LastName),
 		Email:     string(body.Email),
 		Password:  body.Password,
-		Role:      string(body.Role),
+		Role:      shared.Role(body.Role),
 		Location:  body.Location,
 	}
+	registerRequest.IPAddress, registerRequest.UserAgent = middleware.ClientInfoFromContext(ctx)
 
 	response, err := h.authService.Register(registerRequest)
 	if err != nil {
@@ -77,6 +155,117 @@ func (h *AuthHandler) PostAuthRefreshToken(ctx context.Context, request web_auth
 	}, nil
 }
 
+// PostAuthLogout handles POST /auth/logout
+func (h *AuthHandler) PostAuthLogout(ctx context.Context, request web_auth.PostAuthLogoutRequestObject) (web_auth.PostAuthLogoutResponseObject, error) {
+	if err := h.authService.Logout(request.Body.RefreshToken); err != nil {
+		return h.handleLogoutError(err)
+	}
+
+	return web_auth.PostAuthLogout200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Logged out successfully"; return &msg }(),
+	}, nil
+}
+
+// PostAuthLogoutAll handles POST /auth/logout-all
+func (h *AuthHandler) PostAuthLogoutAll(ctx context.Context, request web_auth.PostAuthLogoutAllRequestObject) (web_auth.PostAuthLogoutAllResponseObject, error) {
+	if err := h.authService.LogoutAll(request.Body.RefreshToken); err != nil {
+		return h.handleLogoutAllError(err)
+	}
+
+	return web_auth.PostAuthLogoutAll200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Logged out of all sessions successfully"; return &msg }(),
+	}, nil
+}
+
+// PostAuthVerifyEmail handles POST /auth/verify-email
+func (h *AuthHandler) PostAuthVerifyEmail(ctx context.Context, request web_auth.PostAuthVerifyEmailRequestObject) (web_auth.PostAuthVerifyEmailResponseObject, error) {
+	if err := h.authService.VerifyEmail(request.Body.Token); err != nil {
+		return h.handleVerifyEmailError(err)
+	}
+
+	return web_auth.PostAuthVerifyEmail200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Email verified successfully"; return &msg }(),
+	}, nil
+}
+
+// PostAuthResendVerification handles POST /auth/resend-verification
+func (h *AuthHandler) PostAuthResendVerification(ctx context.Context, request web_auth.PostAuthResendVerificationRequestObject) (web_auth.PostAuthResendVerificationResponseObject, error) {
+	if err := h.authService.ResendVerification(string(request.Body.Email)); err != nil {
+		return h.handleResendVerificationError(err)
+	}
+
+	return web_auth.PostAuthResendVerification202JSONResponse{
+		Code:    func() *int { code := 202; return &code }(),
+		Message: func() *string { msg := "Verification email sent"; return &msg }(),
+	}, nil
+}
+
+// PostAuthForgotPassword handles POST /auth/forgot-password
+func (h *AuthHandler) PostAuthForgotPassword(ctx context.Context, request web_auth.PostAuthForgotPasswordRequestObject) (web_auth.PostAuthForgotPasswordResponseObject, error) {
+	if err := h.authService.ForgotPassword(string(request.Body.Email)); err != nil {
+		return h.handleForgotPasswordError(err)
+	}
+
+	return web_auth.PostAuthForgotPassword202JSONResponse{
+		Code:    func() *int { code := 202; return &code }(),
+		Message: func() *string { msg := "Password reset email sent"; return &msg }(),
+	}, nil
+}
+
+// PostAuthResetPassword handles POST /auth/reset-password
+func (h *AuthHandler) PostAuthResetPassword(ctx context.Context, request web_auth.PostAuthResetPasswordRequestObject) (web_auth.PostAuthResetPasswordResponseObject, error) {
+	body := request.Body
+	resetRequest := &auth.ResetPasswordRequest{
+		Token:       body.Token,
+		NewPassword: body.NewPassword,
+	}
+
+	if err := h.authService.ResetPassword(resetRequest); err != nil {
+		return h.handleResetPasswordError(err)
+	}
+
+	return web_auth.PostAuthResetPassword200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Password reset successfully"; return &msg }(),
+	}, nil
+}
+
+// GetWellKnownJwksJson handles GET /.well-known/jwks.json
+func (h *AuthHandler) GetWellKnownJwksJson(ctx context.Context, request web_auth.GetWellKnownJwksJsonRequestObject) (web_auth.GetWellKnownJwksJsonResponseObject, error) {
+	jwks := h.authService.JWKS()
+
+	keys := make([]web_auth.JSONWebKey, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		keys = append(keys, web_auth.JSONWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   optionalString(key.N),
+			E:   optionalString(key.E),
+			Crv: optionalString(key.Crv),
+			X:   optionalString(key.X),
+		})
+	}
+
+	return web_auth.GetWellKnownJwksJson200JSONResponse{
+		Body: web_auth.JSONWebKeySet{Keys: keys},
+		// Verifiers may cache the keys, rotated keys stay published while their tokens live
+		Headers: web_auth.GetWellKnownJwksJson200ResponseHeaders{CacheControl: "public, max-age=300"},
+	}, nil
+}
+
+// optionalString returns nil for empty strings so they are omitted from responses
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 // handleLoginError converts service errors to appropriate HTTP responses
 func (h *AuthHandler) handleLoginError(err error) (web_auth.PostAuthLoginResponseObject, error) {
 	if apiErr, ok := err.(*shared.APIError); ok {
@@ -85,6 +274,8 @@ func (h *AuthHandler) handleLoginError(err error) (web_auth.PostAuthLoginRespons
 			return web_auth.PostAuthLogin400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		case 401:
 			return web_auth.PostAuthLogin401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 429:
+			return web_auth.PostAuthLogin429JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		default:
 			return web_auth.PostAuthLogin500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		}
@@ -95,6 +286,68 @@ func (h *AuthHandler) handleLoginError(err error) (web_auth.PostAuthLoginRespons
 	return web_auth.PostAuthLogin500JSONResponse{Code: &code, Message: &msg}, nil
 }
 
+// handleOIDCAuthorizeError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleOIDCAuthorizeError(err error) (web_auth.PostAuthOidcProviderAuthorizeResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthOidcProviderAuthorize400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_auth.PostAuthOidcProviderAuthorize404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 502:
+			return web_auth.PostAuthOidcProviderAuthorize502JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthOidcProviderAuthorize500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthOidcProviderAuthorize500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+// handleOIDCCallbackError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleOIDCCallbackError(err error) (web_auth.PostAuthOidcProviderCallbackResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthOidcProviderCallback400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_auth.PostAuthOidcProviderCallback401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_auth.PostAuthOidcProviderCallback404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_auth.PostAuthOidcProviderCallback409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthOidcProviderCallback500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthOidcProviderCallback500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+// handleVerifyTwoFactorError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleVerifyTwoFactorError(err error) (web_auth.PostAuth2faVerifyResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuth2faVerify400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_auth.PostAuth2faVerify401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 429:
+			return web_auth.PostAuth2faVerify429JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuth2faVerify500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuth2faVerify500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
 // handleRegisterError converts service errors to appropriate HTTP responses
 func (h *AuthHandler) handleRegisterError(err error) (web_auth.PostAuthRegisterResponseObject, error) {
 	if apiErr, ok := err.(*shared.APIError); ok {
@@ -130,3 +383,103 @@ func (h *AuthHandler) handleRefreshError(err error) (web_auth.PostAuthRefreshTok
 	msg := "Internal server error"
 	return web_auth.PostAuthRefreshToken500JSONResponse{Code: &code, Message: &msg}, nil
 }
+
+// handleLogoutError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleLogoutError(err error) (web_auth.PostAuthLogoutResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthLogout400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_auth.PostAuthLogout401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthLogout500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthLogout500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+// handleLogoutAllError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleLogoutAllError(err error) (web_auth.PostAuthLogoutAllResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthLogoutAll400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_auth.PostAuthLogoutAll401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthLogoutAll500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthLogoutAll500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+// handleVerifyEmailError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleVerifyEmailError(err error) (web_auth.PostAuthVerifyEmailResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthVerifyEmail400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthVerifyEmail500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthVerifyEmail500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+// handleResendVerificationError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleResendVerificationError(err error) (web_auth.PostAuthResendVerificationResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthResendVerification400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthResendVerification500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthResendVerification500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+// handleForgotPasswordError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleForgotPasswordError(err error) (web_auth.PostAuthForgotPasswordResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthForgotPassword400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthForgotPassword500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthForgotPassword500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+// handleResetPasswordError converts service errors to appropriate HTTP responses
+func (h *AuthHandler) handleResetPasswordError(err error) (web_auth.PostAuthResetPasswordResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_auth.PostAuthResetPassword400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_auth.PostAuthResetPassword500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	// Fallback for unexpected errors
+	code := 500
+	msg := "Internal server error"
+	return web_auth.PostAuthResetPassword500JSONResponse{Code: &code, Message: &msg}, nil
+}
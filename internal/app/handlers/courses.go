@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	web_courses "github.com/IbadT/tutor_app_back.git/internal/web/courses"
@@ -20,41 +21,47 @@ func NewCourseHandler(courseService courses.Service) *CourseHandler {
 
 // GetCourses handles GET /courses
 func (h *CourseHandler) GetCourses(ctx context.Context, request web_courses.GetCoursesRequestObject) (web_courses.GetCoursesResponseObject, error) {
-	courses, err := h.courseService.GetCourses()
+	params := request.Params
+	filter := courses.CourseFilter{
+		CategoryID: (*uuid.UUID)(params.CategoryId),
+		TutorID:    (*uuid.UUID)(params.TutorId),
+		MinRating:  params.MinRating,
+	}
+	if params.Page != nil {
+		filter.Page = *params.Page
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Q != nil {
+		filter.Query = *params.Q
+	}
+	if params.Sort != nil {
+		filter.SortBy = string(*params.Sort)
+	}
+	if params.Order != nil {
+		filter.SortOrder = string(*params.Order)
+	}
+
+	page, err := h.courseService.GetCourses(filter)
 	if err != nil {
 		return h.handleGetCoursesError(err)
 	}
 
 	// Convert domain courses to web response format
-	var responseCourses []web_courses.Course
-	for _, course := range courses {
-		responseCourse := web_courses.Course{
-			Id:               (*openapi_types.UUID)(&course.ID),
-			Title:            &course.Title,
-			Description:      &course.Description,
-			Progress:         &course.Progress,
-			TotalLessons:     &course.TotalLessons,
-			CompletedLessons: &course.CompletedLessons,
-			Duration:         &course.Duration,
-			StudentsCount:    &course.StudentsCount,
-			Rating:           &course.Rating,
-			CategoryId:       (*openapi_types.UUID)(&course.CategoryID),
-			CreatedAt:        &course.CreatedAt,
-			UpdatedAt:        &course.UpdatedAt,
-		}
-
-		// Add related data if available
-		if course.Student != nil {
-			responseCourse.StudentId = (*openapi_types.UUID)(&course.Student.ID)
-		}
-		if course.Tutor != nil {
-			responseCourse.TutorId = (*openapi_types.UUID)(&course.Tutor.ID)
-		}
-
-		responseCourses = append(responseCourses, responseCourse)
+	responseCourses := make([]web_courses.Course, 0, len(page.Courses))
+	for i := range page.Courses {
+		responseCourses = append(responseCourses, toWebCourse(&page.Courses[i]))
 	}
 
-	return web_courses.GetCourses200JSONResponse(responseCourses), nil
+	return web_courses.GetCourses200JSONResponse{
+		Courses: &responseCourses,
+		Pagination: &web_courses.Pagination{
+			Page:  &page.Pagination.Page,
+			Limit: &page.Pagination.Limit,
+			Total: &page.Pagination.Total,
+		},
+	}, nil
 }
 
 // GetCourseByID handles GET /courses/{course_id}
@@ -66,30 +73,104 @@ func (h *CourseHandler) GetCoursesCourseId(ctx context.Context, request web_cour
 		return h.handleGetCourseByIDError(err)
 	}
 
-	responseCourse := web_courses.Course{
-		Id:               (*openapi_types.UUID)(&course.ID),
-		Title:            &course.Title,
-		Description:      &course.Description,
-		Progress:         &course.Progress,
-		TotalLessons:     &course.TotalLessons,
-		CompletedLessons: &course.CompletedLessons,
-		Duration:         &course.Duration,
-		StudentsCount:    &course.StudentsCount,
-		Rating:           &course.Rating,
-		CategoryId:       (*openapi_types.UUID)(&course.CategoryID),
-		CreatedAt:        &course.CreatedAt,
-		UpdatedAt:        &course.UpdatedAt,
+	return web_courses.GetCoursesCourseId200JSONResponse(toWebCourse(course)), nil
+}
+
+// PostCourses handles POST /courses
+func (h *CourseHandler) PostCourses(ctx context.Context, request web_courses.PostCoursesRequestObject) (web_courses.PostCoursesResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleCreateCourseError(shared.ErrUnauthorized)
+	}
+
+	body := request.Body
+	createRequest := &courses.CreateCourseRequest{
+		Title:       body.Title,
+		Description: body.Description,
+		Duration:    body.Duration,
+		CategoryID:  uuid.UUID(body.CategoryId),
+		TutorID:     (*uuid.UUID)(body.TutorId),
 	}
 
-	// Add related data if available
-	if course.Student != nil {
-		responseCourse.StudentId = (*openapi_types.UUID)(&course.Student.ID)
+	course, err := h.courseService.CreateCourse(actorID, createRequest)
+	if err != nil {
+		return h.handleCreateCourseError(err)
 	}
-	if course.Tutor != nil {
-		responseCourse.TutorId = (*openapi_types.UUID)(&course.Tutor.ID)
+
+	return web_courses.PostCourses201JSONResponse(toWebCourse(course)), nil
+}
+
+// PatchCoursesCourseId handles PATCH /courses/{course_id}
+func (h *CourseHandler) PatchCoursesCourseId(ctx context.Context, request web_courses.PatchCoursesCourseIdRequestObject) (web_courses.PatchCoursesCourseIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateCourseError(shared.ErrUnauthorized)
 	}
 
-	return web_courses.GetCoursesCourseId200JSONResponse(responseCourse), nil
+	body := request.Body
+	updateRequest := &courses.UpdateCourseRequest{
+		Title:       body.Title,
+		Description: body.Description,
+		Duration:    body.Duration,
+		CategoryID:  (*uuid.UUID)(body.CategoryId),
+	}
+
+	course, err := h.courseService.UpdateCourse(actorID, uuid.UUID(request.CourseId), updateRequest)
+	if err != nil {
+		return h.handleUpdateCourseError(err)
+	}
+
+	return web_courses.PatchCoursesCourseId200JSONResponse(toWebCourse(course)), nil
+}
+
+// PostCoursesCourseIdArchive handles POST /courses/{course_id}/archive
+func (h *CourseHandler) PostCoursesCourseIdArchive(ctx context.Context, request web_courses.PostCoursesCourseIdArchiveRequestObject) (web_courses.PostCoursesCourseIdArchiveResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleArchiveCourseError(shared.ErrUnauthorized)
+	}
+
+	course, err := h.courseService.ArchiveCourse(actorID, uuid.UUID(request.CourseId))
+	if err != nil {
+		return h.handleArchiveCourseError(err)
+	}
+
+	return web_courses.PostCoursesCourseIdArchive200JSONResponse(toWebCourse(course)), nil
+}
+
+// DeleteCoursesCourseId handles DELETE /courses/{course_id}
+func (h *CourseHandler) DeleteCoursesCourseId(ctx context.Context, request web_courses.DeleteCoursesCourseIdRequestObject) (web_courses.DeleteCoursesCourseIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleDeleteCourseError(shared.ErrUnauthorized)
+	}
+
+	if err := h.courseService.DeleteCourse(actorID, uuid.UUID(request.CourseId)); err != nil {
+		return h.handleDeleteCourseError(err)
+	}
+
+	return web_courses.DeleteCoursesCourseId200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Course deleted successfully"; return &msg }(),
+	}, nil
+}
+
+// toWebCourse converts a domain course to the web response format
+func toWebCourse(course *courses.Course) web_courses.Course {
+	return web_courses.Course{
+		Id:            (*openapi_types.UUID)(&course.ID),
+		Title:         &course.Title,
+		Description:   &course.Description,
+		TotalLessons:  &course.TotalLessons,
+		Duration:      &course.Duration,
+		StudentsCount: &course.StudentsCount,
+		Rating:        &course.Rating,
+		CategoryId:    (*openapi_types.UUID)(&course.CategoryID),
+		IsArchived:    &course.IsArchived,
+		CreatedAt:     &course.CreatedAt,
+		UpdatedAt:     &course.UpdatedAt,
+		TutorId:       (*openapi_types.UUID)(&course.TutorID),
+	}
 }
 
 func (h *CourseHandler) handleGetCourseByIDError(err error) (web_courses.GetCoursesCourseIdResponseObject, error) {
@@ -97,6 +178,8 @@ func (h *CourseHandler) handleGetCourseByIDError(err error) (web_courses.GetCour
 		switch apiErr.Code {
 		case 401:
 			return web_courses.GetCoursesCourseId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_courses.GetCoursesCourseId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		default:
 			return web_courses.GetCoursesCourseId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		}
@@ -109,6 +192,8 @@ func (h *CourseHandler) handleGetCourseByIDError(err error) (web_courses.GetCour
 func (h *CourseHandler) handleGetCoursesError(err error) (web_courses.GetCoursesResponseObject, error) {
 	if apiErr, ok := err.(*shared.APIError); ok {
 		switch apiErr.Code {
+		case 400:
+			return web_courses.GetCourses400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		case 401:
 			return web_courses.GetCourses401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		default:
@@ -119,3 +204,77 @@ func (h *CourseHandler) handleGetCoursesError(err error) (web_courses.GetCourses
 	msg := "Internal server error"
 	return web_courses.GetCourses500JSONResponse{Code: &code, Message: &msg}, nil
 }
+
+func (h *CourseHandler) handleCreateCourseError(err error) (web_courses.PostCoursesResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_courses.PostCourses400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_courses.PostCourses401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_courses.PostCourses403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_courses.PostCourses500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_courses.PostCourses500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *CourseHandler) handleUpdateCourseError(err error) (web_courses.PatchCoursesCourseIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_courses.PatchCoursesCourseId400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_courses.PatchCoursesCourseId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_courses.PatchCoursesCourseId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_courses.PatchCoursesCourseId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_courses.PatchCoursesCourseId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_courses.PatchCoursesCourseId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *CourseHandler) handleArchiveCourseError(err error) (web_courses.PostCoursesCourseIdArchiveResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_courses.PostCoursesCourseIdArchive401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_courses.PostCoursesCourseIdArchive403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_courses.PostCoursesCourseIdArchive404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_courses.PostCoursesCourseIdArchive500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_courses.PostCoursesCourseIdArchive500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *CourseHandler) handleDeleteCourseError(err error) (web_courses.DeleteCoursesCourseIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_courses.DeleteCoursesCourseId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_courses.DeleteCoursesCourseId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_courses.DeleteCoursesCourseId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_courses.DeleteCoursesCourseId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_courses.DeleteCoursesCourseId500JSONResponse{Code: &code, Message: &msg}, nil
+}
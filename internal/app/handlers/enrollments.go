@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	web_enrollments "github.com/IbadT/tutor_app_back.git/internal/web/enrollments"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type EnrollmentHandler struct {
+	enrollmentService enrollments.Service
+}
+
+func NewEnrollmentHandler(enrollmentService enrollments.Service) *EnrollmentHandler {
+	return &EnrollmentHandler{enrollmentService: enrollmentService}
+}
+
+// GetEnrollments handles GET /enrollments
+func (h *EnrollmentHandler) GetEnrollments(ctx context.Context, request web_enrollments.GetEnrollmentsRequestObject) (web_enrollments.GetEnrollmentsResponseObject, error) {
+	studentID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetEnrollmentsError(shared.ErrUnauthorized)
+	}
+
+	studentEnrollments, err := h.enrollmentService.GetStudentEnrollments(studentID)
+	if err != nil {
+		return h.handleGetEnrollmentsError(err)
+	}
+
+	var responseEnrollments []web_enrollments.Enrollment
+	for i := range studentEnrollments {
+		responseEnrollments = append(responseEnrollments, toWebEnrollment(&studentEnrollments[i]))
+	}
+
+	return web_enrollments.GetEnrollments200JSONResponse(responseEnrollments), nil
+}
+
+// GetCoursesCourseIdEnrollment handles GET /courses/{course_id}/enrollment
+func (h *EnrollmentHandler) GetCoursesCourseIdEnrollment(ctx context.Context, request web_enrollments.GetCoursesCourseIdEnrollmentRequestObject) (web_enrollments.GetCoursesCourseIdEnrollmentResponseObject, error) {
+	studentID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetEnrollmentError(shared.ErrUnauthorized)
+	}
+
+	enrollment, err := h.enrollmentService.GetEnrollment(studentID, uuid.UUID(request.CourseId))
+	if err != nil {
+		return h.handleGetEnrollmentError(err)
+	}
+
+	return web_enrollments.GetCoursesCourseIdEnrollment200JSONResponse(toWebEnrollment(enrollment)), nil
+}
+
+// PostCoursesCourseIdEnrollment handles POST /courses/{course_id}/enrollment
+func (h *EnrollmentHandler) PostCoursesCourseIdEnrollment(ctx context.Context, request web_enrollments.PostCoursesCourseIdEnrollmentRequestObject) (web_enrollments.PostCoursesCourseIdEnrollmentResponseObject, error) {
+	studentID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleEnrollError(shared.ErrUnauthorized)
+	}
+
+	enrollment, err := h.enrollmentService.Enroll(studentID, uuid.UUID(request.CourseId))
+	if err != nil {
+		return h.handleEnrollError(err)
+	}
+
+	return web_enrollments.PostCoursesCourseIdEnrollment201JSONResponse(toWebEnrollment(enrollment)), nil
+}
+
+// DeleteCoursesCourseIdEnrollment handles DELETE /courses/{course_id}/enrollment
+func (h *EnrollmentHandler) DeleteCoursesCourseIdEnrollment(ctx context.Context, request web_enrollments.DeleteCoursesCourseIdEnrollmentRequestObject) (web_enrollments.DeleteCoursesCourseIdEnrollmentResponseObject, error) {
+	studentID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUnenrollError(shared.ErrUnauthorized)
+	}
+
+	if err := h.enrollmentService.Unenroll(studentID, uuid.UUID(request.CourseId)); err != nil {
+		return h.handleUnenrollError(err)
+	}
+
+	return web_enrollments.DeleteCoursesCourseIdEnrollment200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Unenrolled successfully"; return &msg }(),
+	}, nil
+}
+
+// toWebEnrollment converts a domain enrollment to the web response format
+func toWebEnrollment(enrollment *enrollments.Enrollment) web_enrollments.Enrollment {
+	return web_enrollments.Enrollment{
+		Id:               (*openapi_types.UUID)(&enrollment.ID),
+		CourseId:         (*openapi_types.UUID)(&enrollment.CourseID),
+		StudentId:        (*openapi_types.UUID)(&enrollment.StudentID),
+		Progress:         &enrollment.Progress,
+		CompletedLessons: &enrollment.CompletedLessons,
+		NextLessonId:     (*openapi_types.UUID)(enrollment.NextLessonID),
+		CreatedAt:        &enrollment.CreatedAt,
+		UpdatedAt:        &enrollment.UpdatedAt,
+	}
+}
+
+func (h *EnrollmentHandler) handleGetEnrollmentsError(err error) (web_enrollments.GetEnrollmentsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_enrollments.GetEnrollments401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_enrollments.GetEnrollments500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_enrollments.GetEnrollments500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *EnrollmentHandler) handleGetEnrollmentError(err error) (web_enrollments.GetCoursesCourseIdEnrollmentResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_enrollments.GetCoursesCourseIdEnrollment401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_enrollments.GetCoursesCourseIdEnrollment404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_enrollments.GetCoursesCourseIdEnrollment500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_enrollments.GetCoursesCourseIdEnrollment500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *EnrollmentHandler) handleEnrollError(err error) (web_enrollments.PostCoursesCourseIdEnrollmentResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_enrollments.PostCoursesCourseIdEnrollment401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_enrollments.PostCoursesCourseIdEnrollment403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_enrollments.PostCoursesCourseIdEnrollment404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_enrollments.PostCoursesCourseIdEnrollment409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_enrollments.PostCoursesCourseIdEnrollment500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_enrollments.PostCoursesCourseIdEnrollment500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *EnrollmentHandler) handleUnenrollError(err error) (web_enrollments.DeleteCoursesCourseIdEnrollmentResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_enrollments.DeleteCoursesCourseIdEnrollment401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_enrollments.DeleteCoursesCourseIdEnrollment404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_enrollments.DeleteCoursesCourseIdEnrollment500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_enrollments.DeleteCoursesCourseIdEnrollment500JSONResponse{Code: &code, Message: &msg}, nil
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/leaderboards"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	web_leaderboards "github.com/IbadT/tutor_app_back.git/internal/web/leaderboards"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// LeaderboardHandler handles leaderboard requests
+type LeaderboardHandler struct {
+	leaderboardService leaderboards.Service
+}
+
+// NewLeaderboardHandler creates a new leaderboard handler
+func NewLeaderboardHandler(leaderboardService leaderboards.Service) *LeaderboardHandler {
+	return &LeaderboardHandler{leaderboardService: leaderboardService}
+}
+
+// GetLeaderboards handles GET /leaderboards
+func (h *LeaderboardHandler) GetLeaderboards(ctx context.Context, request web_leaderboards.GetLeaderboardsRequestObject) (web_leaderboards.GetLeaderboardsResponseObject, error) {
+	viewerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetLeaderboardError(shared.ErrUnauthorized)
+	}
+
+	var period leaderboards.Period
+	if request.Params.Period != nil {
+		period = leaderboards.Period(*request.Params.Period)
+	}
+	var page, limit int
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	leaderboard, err := h.leaderboardService.GetLeaderboard(viewerID, period, (*uuid.UUID)(request.Params.CategoryId), page, limit)
+	if err != nil {
+		return h.handleGetLeaderboardError(err)
+	}
+
+	entries := make([]web_leaderboards.LeaderboardEntry, 0, len(leaderboard.Entries))
+	for i := range leaderboard.Entries {
+		entries = append(entries, toWebLeaderboardEntry(&leaderboard.Entries[i]))
+	}
+	var me *web_leaderboards.LeaderboardEntry
+	if leaderboard.Me != nil {
+		entry := toWebLeaderboardEntry(leaderboard.Me)
+		me = &entry
+	}
+	responsePeriod := web_leaderboards.LeaderboardPeriod(leaderboard.Period)
+
+	return web_leaderboards.GetLeaderboards200JSONResponse{
+		Period:     &responsePeriod,
+		CategoryId: (*openapi_types.UUID)(leaderboard.CategoryID),
+		Entries:    &entries,
+		Me:         me,
+		Pagination: &web_leaderboards.Pagination{
+			Page:  &leaderboard.Page,
+			Limit: &leaderboard.Limit,
+			Total: &leaderboard.Total,
+		},
+	}, nil
+}
+
+// toWebLeaderboardEntry converts a domain leaderboard entry to the web response format
+func toWebLeaderboardEntry(entry *leaderboards.Entry) web_leaderboards.LeaderboardEntry {
+	return web_leaderboards.LeaderboardEntry{
+		Rank:      &entry.Rank,
+		UserId:    (*openapi_types.UUID)(&entry.UserID),
+		FirstName: &entry.FirstName,
+		LastName:  &entry.LastName,
+		Avatar:    &entry.Avatar,
+		Xp:        &entry.XP,
+		Level:     &entry.Level,
+	}
+}
+
+func (h *LeaderboardHandler) handleGetLeaderboardError(err error) (web_leaderboards.GetLeaderboardsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_leaderboards.GetLeaderboards400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_leaderboards.GetLeaderboards401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_leaderboards.GetLeaderboards404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_leaderboards.GetLeaderboards500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_leaderboards.GetLeaderboards500JSONResponse{Code: &code, Message: &msg}, nil
+}
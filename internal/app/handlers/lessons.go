@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/lessons"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	web_lessons "github.com/IbadT/tutor_app_back.git/internal/web/lessons"
@@ -20,54 +21,224 @@ func NewLessonsHandler(lessonsService lessons.Service) *LessonsHandler {
 }
 
 func (h *LessonsHandler) GetLessons(ctx context.Context, request web_lessons.GetLessonsRequestObject) (web_lessons.GetLessonsResponseObject, error) {
-	lessons, err := h.lessonsService.GetLessons()
+	viewerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetLessonsError(shared.ErrUnauthorized)
+	}
+
+	lessons, err := h.lessonsService.GetLessons(viewerID)
 	if err != nil {
 		return h.handleGetLessonsError(err)
 	}
 
 	var responseLessons []web_lessons.Lesson
-	for _, lesson := range lessons {
-		responseLessons = append(responseLessons, web_lessons.Lesson{
-			Id:          (openapi_types.UUID)(lesson.ID),
-			Title:       lesson.Title,
-			Description: lesson.Description,
-			VideoUrl:    lesson.VideoURL,
-			Duration:    lesson.Duration,
-			CreatedAt:   lesson.CreatedAt,
-			UpdatedAt:   lesson.UpdatedAt,
-		})
+	for i := range lessons {
+		responseLessons = append(responseLessons, toWebLesson(&lessons[i]))
 	}
 	return web_lessons.GetLessons200JSONResponse(responseLessons), nil
 }
 
-func (h *LessonsHandler) PostLessonsCreaterId(ctx context.Context, request web_lessons.PostLessonsCreaterIdRequestObject) (web_lessons.PostLessonsCreaterIdResponseObject, error) {
-	creater_id := uuid.UUID(request.CreaterId)
+// GetCoursesCourseIdLessons handles GET /courses/{course_id}/lessons
+func (h *LessonsHandler) GetCoursesCourseIdLessons(ctx context.Context, request web_lessons.GetCoursesCourseIdLessonsRequestObject) (web_lessons.GetCoursesCourseIdLessonsResponseObject, error) {
+	viewerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetCourseLessonsError(shared.ErrUnauthorized)
+	}
+
+	courseLessons, err := h.lessonsService.GetCourseLessons(viewerID, uuid.UUID(request.CourseId))
+	if err != nil {
+		return h.handleGetCourseLessonsError(err)
+	}
+
+	responseLessons := make([]web_lessons.Lesson, 0, len(courseLessons))
+	for i := range courseLessons {
+		responseLessons = append(responseLessons, toWebLesson(&courseLessons[i]))
+	}
+	return web_lessons.GetCoursesCourseIdLessons200JSONResponse(responseLessons), nil
+}
+
+// PutCoursesCourseIdLessonsOrder handles PUT /courses/{course_id}/lessons/order
+func (h *LessonsHandler) PutCoursesCourseIdLessonsOrder(ctx context.Context, request web_lessons.PutCoursesCourseIdLessonsOrderRequestObject) (web_lessons.PutCoursesCourseIdLessonsOrderResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleReorderLessonsError(shared.ErrUnauthorized)
+	}
+
+	lessonIDs := make([]uuid.UUID, 0, len(request.Body.LessonIds))
+	for _, id := range request.Body.LessonIds {
+		lessonIDs = append(lessonIDs, uuid.UUID(id))
+	}
+
+	courseLessons, err := h.lessonsService.ReorderLessons(actorID, uuid.UUID(request.CourseId), lessonIDs)
+	if err != nil {
+		return h.handleReorderLessonsError(err)
+	}
+
+	responseLessons := make([]web_lessons.Lesson, 0, len(courseLessons))
+	for i := range courseLessons {
+		responseLessons = append(responseLessons, toWebLesson(&courseLessons[i]))
+	}
+	return web_lessons.PutCoursesCourseIdLessonsOrder200JSONResponse(responseLessons), nil
+}
+
+// PostLessons handles POST /lessons
+func (h *LessonsHandler) PostLessons(ctx context.Context, request web_lessons.PostLessonsRequestObject) (web_lessons.PostLessonsResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleCreateLessonError(shared.ErrUnauthorized)
+	}
+
+	now := time.Now()
 	lesson := lessons.Lesson{
 		ID:          uuid.New(),
 		Title:       request.Body.Title,
 		Description: request.Body.Description,
 		VideoURL:    request.Body.VideoUrl,
 		Duration:    request.Body.Duration,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
 		CourseID:    request.Body.CourseId,
 	}
-	err := h.lessonsService.CreateLesson(creater_id, &lesson)
-	if err != nil {
+	if err := h.lessonsService.CreateLesson(actorID, &lesson); err != nil {
 		return h.handleCreateLessonError(err)
 	}
-	return web_lessons.PostLessonsCreaterId200JSONResponse{
-		Message: func() *string { msg := "Lesson created successfully"; return &msg }(),
+
+	return web_lessons.PostLessons201JSONResponse(toWebLesson(&lesson)), nil
+}
+
+// PatchLessonsLessonId handles PATCH /lessons/{lesson_id}
+func (h *LessonsHandler) PatchLessonsLessonId(ctx context.Context, request web_lessons.PatchLessonsLessonIdRequestObject) (web_lessons.PatchLessonsLessonIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateLessonError(shared.ErrUnauthorized)
+	}
+
+	body := request.Body
+	updateRequest := &lessons.UpdateLessonRequest{
+		Title:       body.Title,
+		Description: body.Description,
+		VideoURL:    body.VideoUrl,
+		Duration:    body.Duration,
+	}
+
+	lesson, err := h.lessonsService.UpdateLesson(actorID, uuid.UUID(request.LessonId), updateRequest)
+	if err != nil {
+		return h.handleUpdateLessonError(err)
+	}
+
+	return web_lessons.PatchLessonsLessonId200JSONResponse(toWebLesson(lesson)), nil
+}
+
+// DeleteLessonsLessonId handles DELETE /lessons/{lesson_id}
+func (h *LessonsHandler) DeleteLessonsLessonId(ctx context.Context, request web_lessons.DeleteLessonsLessonIdRequestObject) (web_lessons.DeleteLessonsLessonIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleDeleteLessonError(shared.ErrUnauthorized)
+	}
+
+	if err := h.lessonsService.DeleteLesson(actorID, uuid.UUID(request.LessonId)); err != nil {
+		return h.handleDeleteLessonError(err)
+	}
+
+	return web_lessons.DeleteLessonsLessonId200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Lesson deleted successfully"; return &msg }(),
+	}, nil
+}
+
+// PostLessonsLessonIdPublish handles POST /lessons/{lesson_id}/publish
+func (h *LessonsHandler) PostLessonsLessonIdPublish(ctx context.Context, request web_lessons.PostLessonsLessonIdPublishRequestObject) (web_lessons.PostLessonsLessonIdPublishResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handlePublishLessonError(shared.ErrUnauthorized)
+	}
+
+	lesson, err := h.lessonsService.PublishLesson(actorID, uuid.UUID(request.LessonId))
+	if err != nil {
+		return h.handlePublishLessonError(err)
+	}
+
+	return web_lessons.PostLessonsLessonIdPublish200JSONResponse(toWebLesson(lesson)), nil
+}
+
+// PostLessonsLessonIdArchive handles POST /lessons/{lesson_id}/archive
+func (h *LessonsHandler) PostLessonsLessonIdArchive(ctx context.Context, request web_lessons.PostLessonsLessonIdArchiveRequestObject) (web_lessons.PostLessonsLessonIdArchiveResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleArchiveLessonError(shared.ErrUnauthorized)
+	}
+
+	lesson, err := h.lessonsService.ArchiveLesson(actorID, uuid.UUID(request.LessonId))
+	if err != nil {
+		return h.handleArchiveLessonError(err)
+	}
+
+	return web_lessons.PostLessonsLessonIdArchive200JSONResponse(toWebLesson(lesson)), nil
+}
+
+// PostLessonsLessonIdComplete handles POST /lessons/{lesson_id}/complete
+func (h *LessonsHandler) PostLessonsLessonIdComplete(ctx context.Context, request web_lessons.PostLessonsLessonIdCompleteRequestObject) (web_lessons.PostLessonsLessonIdCompleteResponseObject, error) {
+	studentID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleCompleteLessonError(shared.ErrUnauthorized)
+	}
+
+	result, err := h.lessonsService.CompleteLesson(studentID, uuid.UUID(request.LessonId))
+	if err != nil {
+		return h.handleCompleteLessonError(err)
+	}
+
+	enrollment := result.Enrollment
+	return web_lessons.PostLessonsLessonIdComplete200JSONResponse{
+		LessonId:    (*openapi_types.UUID)(&result.LessonID),
+		CompletedAt: &result.CompletedAt,
+		Enrollment: &web_lessons.Enrollment{
+			Id:               (*openapi_types.UUID)(&enrollment.ID),
+			CourseId:         (*openapi_types.UUID)(&enrollment.CourseID),
+			StudentId:        (*openapi_types.UUID)(&enrollment.StudentID),
+			Progress:         &enrollment.Progress,
+			CompletedLessons: &enrollment.CompletedLessons,
+			NextLessonId:     (*openapi_types.UUID)(enrollment.NextLessonID),
+			CreatedAt:        &enrollment.CreatedAt,
+			UpdatedAt:        &enrollment.UpdatedAt,
+		},
 	}, nil
 }
 
-func (h *LessonsHandler) handleCreateLessonError(err error) (web_lessons.PostLessonsCreaterIdResponseObject, error) {
+// toWebLesson converts a domain lesson to the web response format
+func toWebLesson(lesson *lessons.Lesson) web_lessons.Lesson {
+	return web_lessons.Lesson{
+		Id:          (openapi_types.UUID)(lesson.ID),
+		CourseId:    (openapi_types.UUID)(lesson.CourseID),
+		Title:       lesson.Title,
+		Description: lesson.Description,
+		VideoUrl:    lesson.VideoURL,
+		Duration:    lesson.Duration,
+		Position:    lesson.Position,
+		Status:      web_lessons.LessonStatus(lesson.Status),
+		CreatedAt:   lesson.CreatedAt,
+		UpdatedAt:   lesson.UpdatedAt,
+	}
+}
+
+func (h *LessonsHandler) handleCreateLessonError(err error) (web_lessons.PostLessonsResponseObject, error) {
 	if apiErr, ok := err.(*shared.APIError); ok {
-		return web_lessons.PostLessonsCreaterId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		switch apiErr.Code {
+		case 400:
+			return web_lessons.PostLessons400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_lessons.PostLessons401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_lessons.PostLessons403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.PostLessons404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.PostLessons500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
 	}
 	code := 500
 	msg := "Internal server error"
-	return web_lessons.PostLessonsCreaterId500JSONResponse{Code: &code, Message: &msg}, nil
+	return web_lessons.PostLessons500JSONResponse{Code: &code, Message: &msg}, nil
 }
 
 func (h *LessonsHandler) handleGetLessonsError(err error) (web_lessons.GetLessonsResponseObject, error) {
@@ -83,3 +254,135 @@ func (h *LessonsHandler) handleGetLessonsError(err error) (web_lessons.GetLesson
 	msg := "Internal server error"
 	return web_lessons.GetLessons500JSONResponse{Code: &code, Message: &msg}, nil
 }
+
+func (h *LessonsHandler) handleCompleteLessonError(err error) (web_lessons.PostLessonsLessonIdCompleteResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_lessons.PostLessonsLessonIdComplete401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_lessons.PostLessonsLessonIdComplete403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.PostLessonsLessonIdComplete404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.PostLessonsLessonIdComplete500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_lessons.PostLessonsLessonIdComplete500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *LessonsHandler) handleGetCourseLessonsError(err error) (web_lessons.GetCoursesCourseIdLessonsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_lessons.GetCoursesCourseIdLessons401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.GetCoursesCourseIdLessons404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.GetCoursesCourseIdLessons500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_lessons.GetCoursesCourseIdLessons500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *LessonsHandler) handleReorderLessonsError(err error) (web_lessons.PutCoursesCourseIdLessonsOrderResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_lessons.PutCoursesCourseIdLessonsOrder400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_lessons.PutCoursesCourseIdLessonsOrder401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_lessons.PutCoursesCourseIdLessonsOrder403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.PutCoursesCourseIdLessonsOrder404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.PutCoursesCourseIdLessonsOrder500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_lessons.PutCoursesCourseIdLessonsOrder500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *LessonsHandler) handleUpdateLessonError(err error) (web_lessons.PatchLessonsLessonIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_lessons.PatchLessonsLessonId400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_lessons.PatchLessonsLessonId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_lessons.PatchLessonsLessonId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.PatchLessonsLessonId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.PatchLessonsLessonId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_lessons.PatchLessonsLessonId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *LessonsHandler) handleDeleteLessonError(err error) (web_lessons.DeleteLessonsLessonIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_lessons.DeleteLessonsLessonId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_lessons.DeleteLessonsLessonId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.DeleteLessonsLessonId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.DeleteLessonsLessonId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_lessons.DeleteLessonsLessonId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *LessonsHandler) handlePublishLessonError(err error) (web_lessons.PostLessonsLessonIdPublishResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_lessons.PostLessonsLessonIdPublish401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_lessons.PostLessonsLessonIdPublish403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.PostLessonsLessonIdPublish404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_lessons.PostLessonsLessonIdPublish409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.PostLessonsLessonIdPublish500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_lessons.PostLessonsLessonIdPublish500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *LessonsHandler) handleArchiveLessonError(err error) (web_lessons.PostLessonsLessonIdArchiveResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_lessons.PostLessonsLessonIdArchive401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_lessons.PostLessonsLessonIdArchive403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_lessons.PostLessonsLessonIdArchive404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_lessons.PostLessonsLessonIdArchive409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_lessons.PostLessonsLessonIdArchive500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_lessons.PostLessonsLessonIdArchive500JSONResponse{Code: &code, Message: &msg}, nil
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	web_sessions "github.com/IbadT/tutor_app_back.git/internal/web/sessions"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// SessionHandler handles requests about the current user's login sessions
+type SessionHandler struct {
+	authService auth.Service
+}
+
+// NewSessionHandler creates a new session handler
+func NewSessionHandler(authService auth.Service) *SessionHandler {
+	return &SessionHandler{
+		authService: authService,
+	}
+}
+
+// GetUsersMeSessions handles GET /users/me/sessions
+func (h *SessionHandler) GetUsersMeSessions(ctx context.Context, request web_sessions.GetUsersMeSessionsRequestObject) (web_sessions.GetUsersMeSessionsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetSessionsError(shared.ErrUnauthorized)
+	}
+	sessionID, _ := middleware.SessionIDFromContext(ctx)
+
+	sessions, err := h.authService.GetSessions(userID, sessionID)
+	if err != nil {
+		return h.handleGetSessionsError(err)
+	}
+
+	responseSessions := make([]web_sessions.Session, 0, len(sessions))
+	for i := range sessions {
+		responseSessions = append(responseSessions, toWebSession(&sessions[i]))
+	}
+
+	return web_sessions.GetUsersMeSessions200JSONResponse(responseSessions), nil
+}
+
+// DeleteUsersMeSessionsSessionId handles DELETE /users/me/sessions/{session_id}
+func (h *SessionHandler) DeleteUsersMeSessionsSessionId(ctx context.Context, request web_sessions.DeleteUsersMeSessionsSessionIdRequestObject) (web_sessions.DeleteUsersMeSessionsSessionIdResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleRevokeSessionError(shared.ErrUnauthorized)
+	}
+
+	if err := h.authService.RevokeSession(userID, uuid.UUID(request.SessionId)); err != nil {
+		return h.handleRevokeSessionError(err)
+	}
+
+	return web_sessions.DeleteUsersMeSessionsSessionId200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Session revoked"; return &msg }(),
+	}, nil
+}
+
+// toWebSession converts a domain session to the web response format
+func toWebSession(session *auth.Session) web_sessions.Session {
+	return web_sessions.Session{
+		Id:         openapi_types.UUID(session.ID),
+		Device:     session.Device,
+		IpAddress:  session.IPAddress,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		Current:    session.Current,
+	}
+}
+
+func (h *SessionHandler) handleGetSessionsError(err error) (web_sessions.GetUsersMeSessionsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_sessions.GetUsersMeSessions401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_sessions.GetUsersMeSessions500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_sessions.GetUsersMeSessions500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *SessionHandler) handleRevokeSessionError(err error) (web_sessions.DeleteUsersMeSessionsSessionIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_sessions.DeleteUsersMeSessionsSessionId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_sessions.DeleteUsersMeSessionsSessionId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_sessions.DeleteUsersMeSessionsSessionId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_sessions.DeleteUsersMeSessionsSessionId500JSONResponse{Code: &code, Message: &msg}, nil
+}
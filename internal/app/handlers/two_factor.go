@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	web_twofactor "github.com/IbadT/tutor_app_back.git/internal/web/twofactor"
+)
+
+// TwoFactorHandler handles two-factor authentication setup requests
+type TwoFactorHandler struct {
+	authService auth.Service
+}
+
+// NewTwoFactorHandler creates a new two-factor handler
+func NewTwoFactorHandler(authService auth.Service) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		authService: authService,
+	}
+}
+
+// PostAuth2faEnroll handles POST /auth/2fa/enroll
+func (h *TwoFactorHandler) PostAuth2faEnroll(ctx context.Context, request web_twofactor.PostAuth2faEnrollRequestObject) (web_twofactor.PostAuth2faEnrollResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleEnrollError(shared.ErrUnauthorized)
+	}
+
+	enrollment, err := h.authService.EnrollTwoFactor(userID)
+	if err != nil {
+		return h.handleEnrollError(err)
+	}
+
+	return web_twofactor.PostAuth2faEnroll200JSONResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.OTPAuthURI,
+	}, nil
+}
+
+// PostAuth2faConfirm handles POST /auth/2fa/confirm
+func (h *TwoFactorHandler) PostAuth2faConfirm(ctx context.Context, request web_twofactor.PostAuth2faConfirmRequestObject) (web_twofactor.PostAuth2faConfirmResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleConfirmError(shared.ErrUnauthorized)
+	}
+
+	recoveryCodes, err := h.authService.ConfirmTwoFactor(userID, request.Body.Code)
+	if err != nil {
+		return h.handleConfirmError(err)
+	}
+
+	return web_twofactor.PostAuth2faConfirm200JSONResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// PostAuth2faDisable handles POST /auth/2fa/disable
+func (h *TwoFactorHandler) PostAuth2faDisable(ctx context.Context, request web_twofactor.PostAuth2faDisableRequestObject) (web_twofactor.PostAuth2faDisableResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleDisableError(shared.ErrUnauthorized)
+	}
+
+	body := request.Body
+	disableRequest := &auth.DisableTwoFactorRequest{
+		Password: body.Password,
+		Code:     body.Code,
+	}
+	if err := h.authService.DisableTwoFactor(userID, disableRequest); err != nil {
+		return h.handleDisableError(err)
+	}
+
+	return web_twofactor.PostAuth2faDisable200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "Two-factor authentication disabled"; return &msg }(),
+	}, nil
+}
+
+func (h *TwoFactorHandler) handleEnrollError(err error) (web_twofactor.PostAuth2faEnrollResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_twofactor.PostAuth2faEnroll401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_twofactor.PostAuth2faEnroll403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_twofactor.PostAuth2faEnroll409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_twofactor.PostAuth2faEnroll500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_twofactor.PostAuth2faEnroll500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *TwoFactorHandler) handleConfirmError(err error) (web_twofactor.PostAuth2faConfirmResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_twofactor.PostAuth2faConfirm400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_twofactor.PostAuth2faConfirm401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_twofactor.PostAuth2faConfirm409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_twofactor.PostAuth2faConfirm500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_twofactor.PostAuth2faConfirm500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *TwoFactorHandler) handleDisableError(err error) (web_twofactor.PostAuth2faDisableResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_twofactor.PostAuth2faDisable400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_twofactor.PostAuth2faDisable401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_twofactor.PostAuth2faDisable409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_twofactor.PostAuth2faDisable500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_twofactor.PostAuth2faDisable500JSONResponse{Code: &code, Message: &msg}, nil
+}
@@ -2,7 +2,12 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"io"
+	"mime/multipart"
 
+	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/gamification"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
 	web_users "github.com/IbadT/tutor_app_back.git/internal/web/users"
@@ -12,13 +17,15 @@ import (
 
 // UserHandler handles user-related requests
 type UserHandler struct {
-	userService user.Service
+	userService         user.Service
+	gamificationService gamification.Service
 }
 
 // NewUserHandler creates a new user handler
-func NewUserHandler(userService user.Service) *UserHandler {
+func NewUserHandler(userService user.Service, gamificationService gamification.Service) *UserHandler {
 	return &UserHandler{
-		userService: userService,
+		userService:         userService,
+		gamificationService: gamificationService,
 	}
 }
 
@@ -31,19 +38,15 @@ func (h *UserHandler) GetUsersProfileId(ctx context.Context, request web_users.G
 		return h.handleGetUserProfileError(err)
 	}
 
-	return web_users.GetUsersProfileId200JSONResponse{
-		Id:        (*openapi_types.UUID)(&userInfo.ID),
-		FirstName: &userInfo.FirstName,
-		LastName:  &userInfo.LastName,
-		Avatar:    &userInfo.Avatar,
-		Bio:       &userInfo.Bio,
-		Location:  &userInfo.Location,
-		Phone:     &userInfo.Phone,
-	}, nil
+	return web_users.GetUsersProfileId200JSONResponse(toWebUserProfile(userInfo)), nil
 }
 
 // PatchUsersProfileId handles PATCH /users/profile/{id}
 func (h *UserHandler) PatchUsersProfileId(ctx context.Context, request web_users.PatchUsersProfileIdRequestObject) (web_users.PatchUsersProfileIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateUserProfileError(shared.ErrUnauthorized)
+	}
 	userID := uuid.UUID(request.Id)
 
 	body := request.Body
@@ -55,7 +58,7 @@ func (h *UserHandler) PatchUsersProfileId(ctx context.Context, request web_users
 		Phone:     body.Phone,
 	}
 
-	err := h.userService.UpdateUserInfo(userID, updateRequest)
+	err := h.userService.UpdateUserInfo(actorID, userID, updateRequest)
 	if err != nil {
 		return h.handleUpdateUserProfileError(err)
 	}
@@ -65,6 +68,241 @@ func (h *UserHandler) PatchUsersProfileId(ctx context.Context, request web_users
 	}, nil
 }
 
+// GetUsersMe handles GET /users/me
+func (h *UserHandler) GetUsersMe(ctx context.Context, request web_users.GetUsersMeRequestObject) (web_users.GetUsersMeResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetMeError(shared.ErrUnauthorized)
+	}
+
+	userInfo, err := h.userService.GetUserInfo(userID)
+	if err != nil {
+		return h.handleGetMeError(err)
+	}
+
+	return web_users.GetUsersMe200JSONResponse(toWebUserProfile(userInfo)), nil
+}
+
+// PatchUsersMe handles PATCH /users/me
+func (h *UserHandler) PatchUsersMe(ctx context.Context, request web_users.PatchUsersMeRequestObject) (web_users.PatchUsersMeResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateMeError(shared.ErrUnauthorized)
+	}
+
+	body := request.Body
+	updateRequest := &user.UpdateUserInfoRequest{
+		FirstName: body.FirstName,
+		LastName:  body.LastName,
+		Bio:       body.Bio,
+		Location:  body.Location,
+		Phone:     body.Phone,
+	}
+
+	if err := h.userService.UpdateUserInfo(userID, userID, updateRequest); err != nil {
+		return h.handleUpdateMeError(err)
+	}
+
+	return web_users.PatchUsersMe200JSONResponse{
+		Message: func() *string { msg := "User profile updated successfully"; return &msg }(),
+	}, nil
+}
+
+// PutUsersMePassword handles PUT /users/me/password
+func (h *UserHandler) PutUsersMePassword(ctx context.Context, request web_users.PutUsersMePasswordRequestObject) (web_users.PutUsersMePasswordResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateMyPasswordError(shared.ErrUnauthorized)
+	}
+
+	body := request.Body
+	updateRequest := &user.UpdateUserPasswordRequest{
+		CurrentPassword: body.CurrentPassword,
+		NewPassword:     body.NewPassword,
+	}
+
+	if err := h.userService.UpdateUserPassword(userID, userID, updateRequest); err != nil {
+		return h.handleUpdateMyPasswordError(err)
+	}
+
+	return web_users.PutUsersMePassword200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "User password updated successfully"; return &msg }(),
+	}, nil
+}
+
+// PutUsersMeAvatar handles PUT /users/me/avatar
+func (h *UserHandler) PutUsersMeAvatar(ctx context.Context, request web_users.PutUsersMeAvatarRequestObject) (web_users.PutUsersMeAvatarResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUploadMyAvatarError(shared.ErrUnauthorized)
+	}
+
+	data, err := readAvatarFile(request.Body)
+	if err != nil {
+		return h.handleUploadMyAvatarError(err)
+	}
+
+	avatar, err := h.userService.UploadAvatar(ctx, userID, userID, data)
+	if err != nil {
+		return h.handleUploadMyAvatarError(err)
+	}
+
+	return web_users.PutUsersMeAvatar200JSONResponse(toWebAvatar(avatar)), nil
+}
+
+// GetUsersMeStats handles GET /users/me/stats
+func (h *UserHandler) GetUsersMeStats(ctx context.Context, request web_users.GetUsersMeStatsRequestObject) (web_users.GetUsersMeStatsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetMyStatsError(shared.ErrUnauthorized)
+	}
+
+	stats, err := h.userService.GetUserStats(userID)
+	if err != nil {
+		return h.handleGetMyStatsError(err)
+	}
+
+	return web_users.GetUsersMeStats200JSONResponse(toWebUserStats(stats)), nil
+}
+
+// GetUsersMeAchievements handles GET /users/me/achievements
+func (h *UserHandler) GetUsersMeAchievements(ctx context.Context, request web_users.GetUsersMeAchievementsRequestObject) (web_users.GetUsersMeAchievementsResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetMyAchievementsError(shared.ErrUnauthorized)
+	}
+
+	achievements, err := h.userService.GetUserAchievements(userID)
+	if err != nil {
+		return h.handleGetMyAchievementsError(err)
+	}
+
+	return web_users.GetUsersMeAchievements200JSONResponse(toWebUserAchievements(achievements)), nil
+}
+
+// GetUsersMeBadges handles GET /users/me/badges
+func (h *UserHandler) GetUsersMeBadges(ctx context.Context, request web_users.GetUsersMeBadgesRequestObject) (web_users.GetUsersMeBadgesResponseObject, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetMyBadgesError(shared.ErrUnauthorized)
+	}
+
+	badges, err := h.userService.GetUserBadges(userID)
+	if err != nil {
+		return h.handleGetMyBadgesError(err)
+	}
+
+	return web_users.GetUsersMeBadges200JSONResponse(toWebUserBadges(badges)), nil
+}
+
+// toWebUserProfile converts domain user info to the web response format
+func toWebUserProfile(userInfo *user.UserInfo) web_users.UserProfile {
+	return web_users.UserProfile{
+		Id:        (*openapi_types.UUID)(&userInfo.ID),
+		FirstName: &userInfo.FirstName,
+		LastName:  &userInfo.LastName,
+		Avatar:    &userInfo.Avatar,
+		Bio:       &userInfo.Bio,
+		Location:  &userInfo.Location,
+		Phone:     &userInfo.Phone,
+	}
+}
+
+// toWebAvatar converts an uploaded avatar to the web response format
+func toWebAvatar(avatar *user.Avatar) web_users.Avatar {
+	return web_users.Avatar{
+		Url:      &avatar.URL,
+		Variants: &avatar.Variants,
+	}
+}
+
+// toWebFollowsPage converts a page of follows to the web response format
+func toWebFollowsPage(follows *user.FollowsPage) web_users.FollowsPage {
+	users := make([]web_users.FollowUser, 0, len(follows.Users))
+	for i := range follows.Users {
+		followUser := &follows.Users[i]
+		users = append(users, web_users.FollowUser{
+			UserId:     (*openapi_types.UUID)(&followUser.UserID),
+			FirstName:  &followUser.FirstName,
+			LastName:   &followUser.LastName,
+			Avatar:     &followUser.Avatar,
+			FollowedAt: &followUser.FollowedAt,
+		})
+	}
+	return web_users.FollowsPage{
+		Users: &users,
+		Pagination: &web_users.Pagination{
+			Page:  &follows.Page,
+			Limit: &follows.Limit,
+			Total: &follows.Total,
+		},
+	}
+}
+
+// toWebXPLedgerEntry converts a domain XP ledger entry to the web response format
+func toWebXPLedgerEntry(entry *gamification.LedgerEntry) web_users.XPLedgerEntry {
+	eventType := web_users.XPLedgerEntryEventType(entry.EventType)
+	return web_users.XPLedgerEntry{
+		Id:        (*openapi_types.UUID)(&entry.ID),
+		EventType: &eventType,
+		SubjectId: (*openapi_types.UUID)(&entry.SubjectID),
+		Points:    &entry.Points,
+		CreatedAt: &entry.CreatedAt,
+	}
+}
+
+// toWebUserStats converts domain user statistics to the web response format
+func toWebUserStats(stats *user.UserStats) web_users.UserStats {
+	return web_users.UserStats{
+		Id:                (*openapi_types.UUID)(&stats.ID),
+		UserId:            (*openapi_types.UUID)(&stats.UserID),
+		CoursesCompleted:  &stats.CoursesCompleted,
+		CoursesInProgress: &stats.CoursesInProgress,
+		Followers:         &stats.Followers,
+		Following:         &stats.Following,
+		Level:             &stats.Level,
+		Xp:                &stats.XP,
+		NextLevelXp:       &stats.NextLevelXP,
+	}
+}
+
+// toWebUserAchievements converts domain achievements to the web response format
+func toWebUserAchievements(achievements []user.UserAchievements) []web_users.UserAchievement {
+	var responseAchievements []web_users.UserAchievement
+	for i := range achievements {
+		achievement := &achievements[i]
+		responseAchievements = append(responseAchievements, web_users.UserAchievement{
+			Id:              (*openapi_types.UUID)(&achievement.ID),
+			UserId:          (*openapi_types.UUID)(&achievement.UserID),
+			AchievementId:   (*openapi_types.UUID)(achievement.AchievementID),
+			AchievementName: &achievement.AchievementName,
+			Description:     &achievement.Description,
+			Icon:            &achievement.Icon,
+			CreatedAt:       &achievement.CreatedAt,
+		})
+	}
+	return responseAchievements
+}
+
+// toWebUserBadges converts domain badges to the web response format
+func toWebUserBadges(badges []user.UserBadges) []web_users.UserBadge {
+	var responseBadges []web_users.UserBadge
+	for i := range badges {
+		badge := &badges[i]
+		responseBadges = append(responseBadges, web_users.UserBadge{
+			Id:          (*openapi_types.UUID)(&badge.ID),
+			UserId:      (*openapi_types.UUID)(&badge.UserID),
+			BadgeId:     (*openapi_types.UUID)(badge.BadgeID),
+			BadgeName:   &badge.BadgeName,
+			Description: &badge.Description,
+			Icon:        &badge.Icon,
+			CreatedAt:   &badge.CreatedAt,
+		})
+	}
+	return responseBadges
+}
+
 // Error handling methods
 func (h *UserHandler) handleGetUserProfileError(err error) (web_users.GetUsersProfileIdResponseObject, error) {
 	if apiErr, ok := err.(*shared.APIError); ok {
@@ -85,6 +323,10 @@ func (h *UserHandler) handleUpdateUserProfileError(err error) (web_users.PatchUs
 		switch apiErr.Code {
 		case 400:
 			return web_users.PatchUsersProfileId400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PatchUsersProfileId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_users.PatchUsersProfileId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		default:
 			return web_users.PatchUsersProfileId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		}
@@ -103,17 +345,7 @@ func (h *UserHandler) GetUsersIdStats(ctx context.Context, request web_users.Get
 		return h.handleGetUserStatsError(err)
 	}
 
-	return web_users.GetUsersIdStats200JSONResponse{
-		Id:                (*openapi_types.UUID)(&stats.ID),
-		UserId:            (*openapi_types.UUID)(&stats.UserID),
-		CoursesCompleted:  &stats.CoursesCompleted,
-		CoursesInProgress: &stats.CoursesInProgress,
-		Followers:         &stats.Followers,
-		Following:         &stats.Following,
-		Level:             &stats.Level,
-		Xp:                &stats.XP,
-		NextLevelXp:       &stats.NextLevelXP,
-	}, nil
+	return web_users.GetUsersIdStats200JSONResponse(toWebUserStats(stats)), nil
 }
 
 // GetUsersIdAchievements handles GET /users/{id}/achievements
@@ -125,17 +357,7 @@ func (h *UserHandler) GetUsersIdAchievements(ctx context.Context, request web_us
 		return h.handleGetUserAchievementsError(err)
 	}
 
-	// Convert to response format
-	var responseAchievements []web_users.UserAchievement
-	for _, achievement := range achievements {
-		responseAchievements = append(responseAchievements, web_users.UserAchievement{
-			Id:              (*openapi_types.UUID)(&achievement.ID),
-			UserId:          (*openapi_types.UUID)(&achievement.UserID),
-			AchievementName: &achievement.AchievementName,
-		})
-	}
-
-	return web_users.GetUsersIdAchievements200JSONResponse(responseAchievements), nil
+	return web_users.GetUsersIdAchievements200JSONResponse(toWebUserAchievements(achievements)), nil
 }
 
 // GetUsersIdBadges handles GET /users/{id}/badges
@@ -147,21 +369,15 @@ func (h *UserHandler) GetUsersIdBadges(ctx context.Context, request web_users.Ge
 		return h.handleGetUserBadgesError(err)
 	}
 
-	// Convert to response format
-	var responseBadges []web_users.UserBadge
-	for _, badge := range badges {
-		responseBadges = append(responseBadges, web_users.UserBadge{
-			Id:        (*openapi_types.UUID)(&badge.ID),
-			UserId:    (*openapi_types.UUID)(&badge.UserID),
-			BadgeName: &badge.BadgeName,
-		})
-	}
-
-	return web_users.GetUsersIdBadges200JSONResponse(responseBadges), nil
+	return web_users.GetUsersIdBadges200JSONResponse(toWebUserBadges(badges)), nil
 }
 
 // PutUsersProfilePasswordId handles PUT /users/profile/password
 func (h *UserHandler) PutUsersProfilePasswordId(ctx context.Context, request web_users.PutUsersProfilePasswordIdRequestObject) (web_users.PutUsersProfilePasswordIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateUserPasswordError(shared.ErrUnauthorized)
+	}
 	body := request.Body
 	userID := uuid.UUID(request.Id)
 
@@ -170,7 +386,7 @@ func (h *UserHandler) PutUsersProfilePasswordId(ctx context.Context, request web
 		NewPassword:     body.NewPassword,
 	}
 
-	err := h.userService.UpdateUserPassword(userID, updateRequest)
+	err := h.userService.UpdateUserPassword(actorID, userID, updateRequest)
 	if err != nil {
 		return h.handleUpdateUserPasswordError(err)
 	}
@@ -181,7 +397,164 @@ func (h *UserHandler) PutUsersProfilePasswordId(ctx context.Context, request web
 	}, nil
 }
 
+// PutUsersProfileAvatarId handles PUT /users/profile/avatar/{id}
+func (h *UserHandler) PutUsersProfileAvatarId(ctx context.Context, request web_users.PutUsersProfileAvatarIdRequestObject) (web_users.PutUsersProfileAvatarIdResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUploadAvatarError(shared.ErrUnauthorized)
+	}
+
+	data, err := readAvatarFile(request.Body)
+	if err != nil {
+		return h.handleUploadAvatarError(err)
+	}
+
+	avatar, err := h.userService.UploadAvatar(ctx, actorID, uuid.UUID(request.Id), data)
+	if err != nil {
+		return h.handleUploadAvatarError(err)
+	}
+
+	return web_users.PutUsersProfileAvatarId200JSONResponse(toWebAvatar(avatar)), nil
+}
+
+// readAvatarFile reads the "file" part of an avatar upload. At most one byte more than
+// user.MaxAvatarBytes is read, so oversized files are rejected without buffering them.
+func readAvatarFile(body *multipart.Reader) ([]byte, error) {
+	if body == nil {
+		return nil, shared.ErrMissingFields
+	}
+	for {
+		part, err := body.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, shared.ErrMissingFields
+		}
+		if err != nil {
+			return nil, shared.ErrBadRequest
+		}
+		if part.FormName() != "file" {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(part, user.MaxAvatarBytes+1))
+		if err != nil {
+			return nil, shared.ErrBadRequest
+		}
+		return data, nil
+	}
+}
+
+// PostUsersIdFollow handles POST /users/{id}/follow
+func (h *UserHandler) PostUsersIdFollow(ctx context.Context, request web_users.PostUsersIdFollowRequestObject) (web_users.PostUsersIdFollowResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleFollowError(shared.ErrUnauthorized)
+	}
+
+	follow, err := h.userService.Follow(actorID, uuid.UUID(request.Id))
+	if err != nil {
+		return h.handleFollowError(err)
+	}
+
+	return web_users.PostUsersIdFollow201JSONResponse{
+		FollowerId: (*openapi_types.UUID)(&follow.FollowerID),
+		FolloweeId: (*openapi_types.UUID)(&follow.FolloweeID),
+		CreatedAt:  &follow.CreatedAt,
+	}, nil
+}
+
+// DeleteUsersIdFollow handles DELETE /users/{id}/follow
+func (h *UserHandler) DeleteUsersIdFollow(ctx context.Context, request web_users.DeleteUsersIdFollowRequestObject) (web_users.DeleteUsersIdFollowResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUnfollowError(shared.ErrUnauthorized)
+	}
+
+	if err := h.userService.Unfollow(actorID, uuid.UUID(request.Id)); err != nil {
+		return h.handleUnfollowError(err)
+	}
+
+	return web_users.DeleteUsersIdFollow200JSONResponse{
+		Code:    func() *int { code := 200; return &code }(),
+		Message: func() *string { msg := "User unfollowed successfully"; return &msg }(),
+	}, nil
+}
+
+// GetUsersIdFollowers handles GET /users/{id}/followers
+func (h *UserHandler) GetUsersIdFollowers(ctx context.Context, request web_users.GetUsersIdFollowersRequestObject) (web_users.GetUsersIdFollowersResponseObject, error) {
+	var page, limit int
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	follows, err := h.userService.GetFollowers(uuid.UUID(request.Id), page, limit)
+	if err != nil {
+		return h.handleGetFollowersError(err)
+	}
+
+	return web_users.GetUsersIdFollowers200JSONResponse(toWebFollowsPage(follows)), nil
+}
+
+// GetUsersIdFollowing handles GET /users/{id}/following
+func (h *UserHandler) GetUsersIdFollowing(ctx context.Context, request web_users.GetUsersIdFollowingRequestObject) (web_users.GetUsersIdFollowingResponseObject, error) {
+	var page, limit int
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	follows, err := h.userService.GetFollowing(uuid.UUID(request.Id), page, limit)
+	if err != nil {
+		return h.handleGetFollowingError(err)
+	}
+
+	return web_users.GetUsersIdFollowing200JSONResponse(toWebFollowsPage(follows)), nil
+}
+
+// GetUsersIdXp handles GET /users/{id}/xp
+func (h *UserHandler) GetUsersIdXp(ctx context.Context, request web_users.GetUsersIdXpRequestObject) (web_users.GetUsersIdXpResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleGetXPLedgerError(shared.ErrUnauthorized)
+	}
+
+	var page, limit int
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	ledger, err := h.gamificationService.GetLedger(actorID, uuid.UUID(request.Id), page, limit)
+	if err != nil {
+		return h.handleGetXPLedgerError(err)
+	}
+
+	entries := make([]web_users.XPLedgerEntry, 0, len(ledger.Entries))
+	for i := range ledger.Entries {
+		entries = append(entries, toWebXPLedgerEntry(&ledger.Entries[i]))
+	}
+
+	return web_users.GetUsersIdXp200JSONResponse{
+		Entries: &entries,
+		Pagination: &web_users.Pagination{
+			Page:  &ledger.Page,
+			Limit: &ledger.Limit,
+			Total: &ledger.Total,
+		},
+	}, nil
+}
+
+// PutUsersReplacerIdStudentsStudentIdStatus handles PUT /users/{replacer_id}/students/{student_id}/status
 func (h *UserHandler) PutUsersReplacerIdStudentsStudentIdStatus(ctx context.Context, request web_users.PutUsersReplacerIdStudentsStudentIdStatusRequestObject) (web_users.PutUsersReplacerIdStudentsStudentIdStatusResponseObject, error) {
+	actorID, ok := middleware.UserIDFromContext(ctx)
+	if !ok {
+		return h.handleUpdateStudentStatusError(shared.ErrUnauthorized)
+	}
 	replacerID := uuid.UUID(request.ReplacerId)
 	studentID := uuid.UUID(request.StudentId)
 	body := *request.Body
@@ -190,7 +563,7 @@ func (h *UserHandler) PutUsersReplacerIdStudentsStudentIdStatus(ctx context.Cont
 		IsVerified: body.IsVerified,
 	}
 
-	err := h.userService.UpdateStudentStatus(replacerID, studentID, requestBody)
+	err := h.userService.UpdateStudentStatus(actorID, replacerID, studentID, requestBody)
 	if err != nil {
 		return h.handleUpdateStudentStatusError(err)
 	}
@@ -206,6 +579,12 @@ func (h *UserHandler) handleUpdateStudentStatusError(err error) (web_users.PutUs
 		switch apiErr.Code {
 		case 400:
 			return web_users.PutUsersReplacerIdStudentsStudentIdStatus400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PutUsersReplacerIdStudentsStudentIdStatus401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_users.PutUsersReplacerIdStudentsStudentIdStatus403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_users.PutUsersReplacerIdStudentsStudentIdStatus404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		default:
 			return web_users.PutUsersReplacerIdStudentsStudentIdStatus500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		}
@@ -220,6 +599,12 @@ func (h *UserHandler) handleUpdateUserPasswordError(err error) (web_users.PutUse
 		switch apiErr.Code {
 		case 400:
 			return web_users.PutUsersProfilePasswordId400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PutUsersProfilePasswordId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_users.PutUsersProfilePasswordId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_users.PutUsersProfilePasswordId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		default:
 			return web_users.PutUsersProfilePasswordId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
 		}
@@ -271,3 +656,221 @@ func (h *UserHandler) handleGetUserBadgesError(err error) (web_users.GetUsersIdB
 	msg := "Internal server error"
 	return web_users.GetUsersIdBadges500JSONResponse{Code: &code, Message: &msg}, nil
 }
+
+func (h *UserHandler) handleGetMeError(err error) (web_users.GetUsersMeResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_users.GetUsersMe401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.GetUsersMe500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.GetUsersMe500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleUpdateMeError(err error) (web_users.PatchUsersMeResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.PatchUsersMe400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PatchUsersMe401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.PatchUsersMe500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.PatchUsersMe500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleUpdateMyPasswordError(err error) (web_users.PutUsersMePasswordResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.PutUsersMePassword400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PutUsersMePassword401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.PutUsersMePassword500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.PutUsersMePassword500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleGetMyStatsError(err error) (web_users.GetUsersMeStatsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_users.GetUsersMeStats401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.GetUsersMeStats500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.GetUsersMeStats500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleGetMyAchievementsError(err error) (web_users.GetUsersMeAchievementsResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_users.GetUsersMeAchievements401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.GetUsersMeAchievements500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.GetUsersMeAchievements500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleGetMyBadgesError(err error) (web_users.GetUsersMeBadgesResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_users.GetUsersMeBadges401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.GetUsersMeBadges500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.GetUsersMeBadges500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleUploadAvatarError(err error) (web_users.PutUsersProfileAvatarIdResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.PutUsersProfileAvatarId400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PutUsersProfileAvatarId401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_users.PutUsersProfileAvatarId403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_users.PutUsersProfileAvatarId404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 413:
+			return web_users.PutUsersProfileAvatarId413JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.PutUsersProfileAvatarId500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.PutUsersProfileAvatarId500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleUploadMyAvatarError(err error) (web_users.PutUsersMeAvatarResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.PutUsersMeAvatar400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PutUsersMeAvatar401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 413:
+			return web_users.PutUsersMeAvatar413JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.PutUsersMeAvatar500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.PutUsersMeAvatar500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleFollowError(err error) (web_users.PostUsersIdFollowResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.PostUsersIdFollow400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.PostUsersIdFollow401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_users.PostUsersIdFollow404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 409:
+			return web_users.PostUsersIdFollow409JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.PostUsersIdFollow500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.PostUsersIdFollow500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleUnfollowError(err error) (web_users.DeleteUsersIdFollowResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 401:
+			return web_users.DeleteUsersIdFollow401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_users.DeleteUsersIdFollow404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.DeleteUsersIdFollow500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.DeleteUsersIdFollow500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleGetFollowersError(err error) (web_users.GetUsersIdFollowersResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.GetUsersIdFollowers400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.GetUsersIdFollowers401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_users.GetUsersIdFollowers404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.GetUsersIdFollowers500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.GetUsersIdFollowers500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleGetFollowingError(err error) (web_users.GetUsersIdFollowingResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.GetUsersIdFollowing400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.GetUsersIdFollowing401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 404:
+			return web_users.GetUsersIdFollowing404JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.GetUsersIdFollowing500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.GetUsersIdFollowing500JSONResponse{Code: &code, Message: &msg}, nil
+}
+
+func (h *UserHandler) handleGetXPLedgerError(err error) (web_users.GetUsersIdXpResponseObject, error) {
+	if apiErr, ok := err.(*shared.APIError); ok {
+		switch apiErr.Code {
+		case 400:
+			return web_users.GetUsersIdXp400JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 401:
+			return web_users.GetUsersIdXp401JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		case 403:
+			return web_users.GetUsersIdXp403JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		default:
+			return web_users.GetUsersIdXp500JSONResponse{Code: &apiErr.Code, Message: &apiErr.Message}, nil
+		}
+	}
+	code := 500
+	msg := "Internal server error"
+	return web_users.GetUsersIdXp500JSONResponse{Code: &code, Message: &msg}, nil
+}
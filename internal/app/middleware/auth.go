@@ -1,40 +1,30 @@
 package middleware
 
 import (
+	"context"
 	"strings"
 
 	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
+// contextKey is the type of keys stored by middleware in the request context
+type contextKey string
+
+const (
+	userIDContextKey    contextKey = "user_id"
+	userRoleContextKey  contextKey = "user_role"
+	sessionIDContextKey contextKey = "session_id"
+)
+
 // AuthMiddleware creates authentication middleware
 func AuthMiddleware(authService auth.Service) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Get Authorization header
-			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return echo.NewHTTPError(401, "Authorization header required")
-			}
-
-			// Check if it starts with "Bearer "
-			if !strings.HasPrefix(authHeader, "Bearer ") {
-				return echo.NewHTTPError(401, "Invalid authorization header format")
+			if err := authenticate(c, authService); err != nil {
+				return err
 			}
-
-			// Extract token
-			token := strings.TrimPrefix(authHeader, "Bearer ")
-
-			// Validate token
-			userID, role, err := authService.ValidateToken(token)
-			if err != nil {
-				return echo.NewHTTPError(401, "Invalid token")
-			}
-
-			// Set user context
-			c.Set("user_id", userID)
-			c.Set("user_role", role)
-
 			return next(c)
 		}
 	}
@@ -44,19 +34,80 @@ func AuthMiddleware(authService auth.Service) echo.MiddlewareFunc {
 func RoleMiddleware(allowedRoles ...string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			userRole, ok := c.Get("user_role").(string)
-			if !ok {
-				return echo.NewHTTPError(401, "User role not found in context")
+			if err := requireRole(c, allowedRoles); err != nil {
+				return err
 			}
+			return next(c)
+		}
+	}
+}
 
-			// Check if user role is in allowed roles
-			for _, role := range allowedRoles {
-				if userRole == role {
-					return next(c)
-				}
-			}
+// authenticate validates the bearer token of the request and stores its user in the context
+func authenticate(c echo.Context, authService auth.Service) error {
+	// Get Authorization header
+	authHeader := c.Request().Header.Get("Authorization")
+	if authHeader == "" {
+		return echo.NewHTTPError(401, "Authorization header required")
+	}
+
+	// Check if it starts with "Bearer "
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return echo.NewHTTPError(401, "Invalid authorization header format")
+	}
+
+	// Extract token
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+
+	// Validate token, including that its session has not been revoked
+	claims, err := authService.ValidateToken(token)
+	if err != nil {
+		return echo.NewHTTPError(401, "Invalid token")
+	}
+
+	// Set user context
+	c.Set("user_id", claims.UserID)
+	c.Set("user_role", claims.Role)
 
-			return echo.NewHTTPError(403, "Insufficient permissions")
+	// Strict handlers only see the request context, so mirror the values there
+	ctx := context.WithValue(c.Request().Context(), userIDContextKey, claims.UserID)
+	ctx = context.WithValue(ctx, userRoleContextKey, claims.Role)
+	ctx = context.WithValue(ctx, sessionIDContextKey, claims.SessionID)
+	c.SetRequest(c.Request().WithContext(ctx))
+
+	return nil
+}
+
+// requireRole checks that the authenticated user has one of the allowed roles
+func requireRole(c echo.Context, allowedRoles []string) error {
+	userRole, ok := c.Get("user_role").(string)
+	if !ok {
+		return echo.NewHTTPError(401, "User role not found in context")
+	}
+
+	// Check if user role is in allowed roles
+	for _, role := range allowedRoles {
+		if userRole == role {
+			return nil
 		}
 	}
+
+	return echo.NewHTTPError(403, "Insufficient permissions")
+}
+
+// UserIDFromContext returns the authenticated user ID stored by AuthMiddleware
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(userIDContextKey).(uuid.UUID)
+	return userID, ok && userID != uuid.Nil
+}
+
+// UserRoleFromContext returns the authenticated user role stored by AuthMiddleware
+func UserRoleFromContext(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(userRoleContextKey).(string)
+	return role, ok
+}
+
+// SessionIDFromContext returns the session of the access token stored by AuthMiddleware
+func SessionIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	sessionID, ok := ctx.Value(sessionIDContextKey).(uuid.UUID)
+	return sessionID, ok && sessionID != uuid.Nil
 }
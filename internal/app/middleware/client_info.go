@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	clientIPContextKey  contextKey = "client_ip"
+	userAgentContextKey contextKey = "user_agent"
+)
+
+// ClientInfoMiddleware stores the client IP address and user agent in the request context
+func ClientInfoMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := context.WithValue(c.Request().Context(), clientIPContextKey, c.RealIP())
+			ctx = context.WithValue(ctx, userAgentContextKey, c.Request().UserAgent())
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// ClientInfoFromContext returns the client IP address and user agent stored by ClientInfoMiddleware
+func ClientInfoFromContext(ctx context.Context) (ip, userAgent string) {
+	ip, _ = ctx.Value(clientIPContextKey).(string)
+	userAgent, _ = ctx.Value(userAgentContextKey).(string)
+	return ip, userAgent
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/labstack/echo/v4"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+)
+
+// bearerAuthScopes is the echo context key the generated wrappers store the BearerAuth
+// scopes of an operation under. It is only set for operations declaring the scheme.
+const bearerAuthScopes = "BearerAuth.Scopes"
+
+// SecurityMiddleware applies the security requirements declared in openapi.yaml to the
+// generated strict handlers. Operations declaring BearerAuth require a valid access token,
+// and when scopes are listed, e.g. `BearerAuth: [admin]`, one of those roles.
+// Operations without a security requirement stay public.
+func SecurityMiddleware(authService auth.Service) strictecho.StrictEchoMiddlewareFunc {
+	return func(next strictecho.StrictEchoHandlerFunc, operationID string) strictecho.StrictEchoHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			scopes, secured := c.Get(bearerAuthScopes).([]string)
+			if !secured {
+				return next(c, request)
+			}
+
+			if err := authenticate(c, authService); err != nil {
+				return nil, err
+			}
+			if len(scopes) > 0 {
+				if err := requireRole(c, scopes); err != nil {
+					return nil, err
+				}
+			}
+
+			return next(c, request)
+		}
+	}
+}
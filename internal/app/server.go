@@ -1,20 +1,33 @@
 package app
 
 import (
+	"context"
 	"log"
+	"os"
 
 	"github.com/IbadT/tutor_app_back.git/internal/app/handlers"
 	"github.com/IbadT/tutor_app_back.git/internal/app/middleware"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/achievements"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/authz"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/gamification"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/leaderboards"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/lessons"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
 	"github.com/IbadT/tutor_app_back.git/internal/infrastructure/database"
+	"github.com/IbadT/tutor_app_back.git/internal/infrastructure/events"
 	"github.com/IbadT/tutor_app_back.git/internal/infrastructure/external"
 	"github.com/IbadT/tutor_app_back.git/internal/infrastructure/repositories"
+	web_admin "github.com/IbadT/tutor_app_back.git/internal/web/admin"
 	web_auth "github.com/IbadT/tutor_app_back.git/internal/web/auth"
 	web_courses "github.com/IbadT/tutor_app_back.git/internal/web/courses"
+	web_enrollments "github.com/IbadT/tutor_app_back.git/internal/web/enrollments"
+	web_leaderboards "github.com/IbadT/tutor_app_back.git/internal/web/leaderboards"
 	web_lessons "github.com/IbadT/tutor_app_back.git/internal/web/lessons"
+	web_sessions "github.com/IbadT/tutor_app_back.git/internal/web/sessions"
+	web_twofactor "github.com/IbadT/tutor_app_back.git/internal/web/twofactor"
 	web_users "github.com/IbadT/tutor_app_back.git/internal/web/users"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
@@ -39,36 +52,109 @@ func NewServer() (*Server, error) {
 	// Set custom error handler
 	e.HTTPErrorHandler = middleware.ErrorHandler
 
+	// Client IPs feed login throttling, so forwarding headers are only trusted behind a proxy
+	if os.Getenv("TRUST_PROXY_HEADERS") == "true" {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	} else {
+		e.IPExtractor = echo.ExtractIPDirect()
+	}
+
 	// Initialize repositories
+	uow := database.NewUnitOfWork(db)
 	userRepo := repositories.NewUserRepository(db)
 	authRepo := repositories.NewAuthRepository(db)
 	courseRepo := repositories.NewCourseRepository(db)
 	lessonRepo := repositories.NewLessonsRepository(db)
+	enrollmentRepo := repositories.NewEnrollmentRepository(db)
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(db)
+	sessionRepo := repositories.NewSessionRepository(db)
+	userTokenRepo := repositories.NewUserTokenRepository(db)
+	loginThrottleRepo := repositories.NewLoginThrottleRepository(db)
+	twoFactorRepo := repositories.NewTwoFactorRepository(db)
+	oidcRepo := repositories.NewOIDCRepository(db)
+	gamificationRepo := repositories.NewGamificationRepository(db)
+	achievementsRepo := repositories.NewAchievementsRepository(db)
+	leaderboardsRepo := repositories.NewLeaderboardsRepository(db)
 
 	// Initialize external services
-	jwtService := external.NewJWTService()
+	jwtService, err := external.NewJWTService()
+	if err != nil {
+		return nil, err
+	}
 	passwordService := external.NewPasswordService()
+	totpService := external.NewTOTPService()
+	secretCipher, err := external.NewSecretCipher()
+	if err != nil {
+		return nil, err
+	}
+	mailer := external.NewOutboxMailer(os.Getenv("MAIL_OUTBOX_DIR"))
+	imageProcessor := external.NewImageProcessor()
+	blobStore, uploadsDir, err := loadBlobStore(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// Load configuration
+	authConfig, err := loadAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	verificationPolicy := loadVerificationPolicy()
+	oidcProviders, err := loadOIDCProviders()
+	if err != nil {
+		return nil, err
+	}
+	gamificationConfig, err := loadGamificationConfig()
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize domain services
-	userService := user.NewService(userRepo, passwordService)
-	authService := auth.NewService(authRepo, userRepo, jwtService, passwordService)
-	courseService := courses.NewService(courseRepo)
-	lessonService := lessons.NewService(lessonRepo, userRepo)
+	eventBus := events.NewBus()
+	policy := authz.NewPolicy(enrollmentRepo)
+	userService := user.NewService(userRepo, passwordService, policy, blobStore, imageProcessor, eventBus)
+	authService := auth.NewService(authConfig, uow, authRepo, userRepo, refreshTokenRepo, sessionRepo, userTokenRepo, loginThrottleRepo, twoFactorRepo, oidcRepo, jwtService, passwordService, mailer, totpService, secretCipher, oidcProviders)
+	courseService := courses.NewService(courseRepo, userRepo, verificationPolicy)
+	lessonService := lessons.NewService(lessonRepo, courseRepo, userRepo, enrollmentRepo, verificationPolicy, eventBus)
+	enrollmentService := enrollments.NewService(enrollmentRepo, courseRepo, userRepo, verificationPolicy)
+	gamificationService := gamification.NewService(gamificationConfig, uow, gamificationRepo, userRepo, policy)
+	achievementsService := achievements.NewService(achievementsRepo, userRepo)
+	leaderboardService := leaderboards.NewService(leaderboardsRepo)
+	// Achievements are evaluated after XP is awarded for the same event
+	gamificationService.Subscribe(eventBus)
+	achievementsService.Subscribe(eventBus)
 
 	// Initialize handlers
-	userHandler := handlers.NewUserHandler(userService)
+	userHandler := handlers.NewUserHandler(userService, gamificationService)
 	authHandler := handlers.NewAuthHandler(authService)
 	courseHandler := handlers.NewCourseHandler(courseService)
 	lessonHandler := handlers.NewLessonsHandler(lessonService)
-
-	// Create strict handlers for OpenAPI
-	userStrictHandler := web_users.NewStrictHandler(userHandler, nil)
-	authStrictHandler := web_auth.NewStrictHandler(authHandler, nil)
-	courseStrictHandler := web_courses.NewStrictHandler(courseHandler, nil)
-	lessonStrictHandler := web_lessons.NewStrictHandler(lessonHandler, nil)
+	enrollmentHandler := handlers.NewEnrollmentHandler(enrollmentService)
+	adminHandler := handlers.NewAdminHandler(authService, userService, gamificationService, achievementsService)
+	twoFactorHandler := handlers.NewTwoFactorHandler(authService)
+	sessionHandler := handlers.NewSessionHandler(authService)
+	leaderboardHandler := handlers.NewLeaderboardHandler(leaderboardService)
+
+	// Create strict handlers for OpenAPI. The security middleware authenticates the
+	// operations that declare BearerAuth in openapi.yaml, the others stay public.
+	security := middleware.SecurityMiddleware(authService)
+	userStrictHandler := web_users.NewStrictHandler(userHandler, []web_users.StrictMiddlewareFunc{security})
+	authStrictHandler := web_auth.NewStrictHandler(authHandler, []web_auth.StrictMiddlewareFunc{security})
+	courseStrictHandler := web_courses.NewStrictHandler(courseHandler, []web_courses.StrictMiddlewareFunc{security})
+	lessonStrictHandler := web_lessons.NewStrictHandler(lessonHandler, []web_lessons.StrictMiddlewareFunc{security})
+	enrollmentStrictHandler := web_enrollments.NewStrictHandler(enrollmentHandler, []web_enrollments.StrictMiddlewareFunc{security})
+	adminStrictHandler := web_admin.NewStrictHandler(adminHandler, []web_admin.StrictMiddlewareFunc{security})
+	twoFactorStrictHandler := web_twofactor.NewStrictHandler(twoFactorHandler, []web_twofactor.StrictMiddlewareFunc{security})
+	sessionStrictHandler := web_sessions.NewStrictHandler(sessionHandler, []web_sessions.StrictMiddlewareFunc{security})
+	leaderboardStrictHandler := web_leaderboards.NewStrictHandler(leaderboardHandler, []web_leaderboards.StrictMiddlewareFunc{security})
 
 	// Register routes
-	registerRoutes(e, userStrictHandler, authStrictHandler, courseStrictHandler, lessonStrictHandler, authService)
+	registerRoutes(e, userStrictHandler, authStrictHandler, courseStrictHandler, lessonStrictHandler, enrollmentStrictHandler, adminStrictHandler, twoFactorStrictHandler, sessionStrictHandler, leaderboardStrictHandler)
+
+	// Uploaded files of the local blob store
+	if uploadsDir != "" {
+		e.Static("/uploads", uploadsDir)
+	}
 
 	// Setup middleware
 	setupMiddleware(e)
@@ -83,7 +169,11 @@ func registerRoutes(
 	authHandler web_auth.ServerInterface,
 	courseHandler web_courses.ServerInterface,
 	lessonHandler web_lessons.ServerInterface,
-	authService auth.Service,
+	enrollmentHandler web_enrollments.ServerInterface,
+	adminHandler web_admin.ServerInterface,
+	twoFactorHandler web_twofactor.ServerInterface,
+	sessionHandler web_sessions.ServerInterface,
+	leaderboardHandler web_leaderboards.ServerInterface,
 ) {
 
 	// Static files for Swagger UI
@@ -92,23 +182,17 @@ func registerRoutes(
 	// OpenAPI specification file
 	e.File("/openapi.yaml", "openapi/openapi.yaml")
 
-	// Auth routes (no authentication required)
+	// API routes. Authentication and roles are applied per operation from the
+	// security requirements of openapi.yaml by the strict handler middleware.
 	web_auth.RegisterHandlers(e, authHandler)
-
-	// User routes (authentication required)
-	userGroup := e.Group("/users")
-	userGroup.Use(middleware.AuthMiddleware(authService))
 	web_users.RegisterHandlers(e, userHandler)
-
-	// Course routes (authentication required)
-	courseGroup := e.Group("/courses")
-	courseGroup.Use(middleware.AuthMiddleware(authService))
 	web_courses.RegisterHandlers(e, courseHandler)
-
-	// Lesson routes (authentication required)
-	lessonGroup := e.Group("/lessons")
-	lessonGroup.Use(middleware.AuthMiddleware(authService))
+	web_enrollments.RegisterHandlers(e, enrollmentHandler)
 	web_lessons.RegisterHandlers(e, lessonHandler)
+	web_twofactor.RegisterHandlers(e, twoFactorHandler)
+	web_sessions.RegisterHandlers(e, sessionHandler)
+	web_admin.RegisterHandlers(e, adminHandler)
+	web_leaderboards.RegisterHandlers(e, leaderboardHandler)
 }
 
 // setupMiddleware configures Echo middleware
@@ -124,6 +208,9 @@ func setupMiddleware(e *echo.Echo) {
 
 	// Request ID middleware
 	e.Use(echoMiddleware.RequestID())
+
+	// Client IP and user agent for handlers
+	e.Use(middleware.ClientInfoMiddleware())
 }
 
 // Start starts the server
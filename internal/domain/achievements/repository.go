@@ -0,0 +1,26 @@
+package achievements
+
+import (
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for achievement and badge data operations.
+// Every method works on the catalog of the given kind.
+type Repository interface {
+	// Definition operations
+	ListDefinitions(kind Kind) ([]Definition, error)
+	GetDefinition(kind Kind, id uuid.UUID) (*Definition, error)
+	GetDefinitionByName(kind Kind, name string) (*Definition, error)
+	// CreateDefinition stores the definition and grants it to every user already meeting it
+	CreateDefinition(definition *Definition) error
+	// UpdateDefinition stores the changes and grants the definition to every user meeting
+	// the new criteria. Users who already have it keep it.
+	UpdateDefinition(definition *Definition) error
+	// DeleteDefinition removes the definition and the grants of it
+	DeleteDefinition(kind Kind, id uuid.UUID) error
+
+	// GetMetrics returns every metric of a user
+	GetMetrics(userID uuid.UUID) (map[Metric]int, error)
+	// Grant gives the definition to a user, a definition the user already has is left as is
+	Grant(userID uuid.UUID, definition *Definition) error
+}
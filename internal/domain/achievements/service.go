@@ -0,0 +1,242 @@
+package achievements
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Service defines the interface for achievement and badge business logic
+type Service interface {
+	// Subscribe registers the evaluator for the events that change metrics.
+	// It must run after the gamification service, so XP and level are up to date.
+	Subscribe(bus shared.EventBus)
+
+	ListDefinitions(actorID uuid.UUID, kind Kind) ([]Definition, error)
+	GetDefinition(actorID uuid.UUID, kind Kind, id uuid.UUID) (*Definition, error)
+	CreateDefinition(actorID uuid.UUID, kind Kind, req *DefinitionRequest) (*Definition, error)
+	UpdateDefinition(actorID uuid.UUID, kind Kind, id uuid.UUID, req *DefinitionRequest) (*Definition, error)
+	DeleteDefinition(actorID uuid.UUID, kind Kind, id uuid.UUID) error
+}
+
+// evaluatedEvents are the events after which criteria are evaluated again
+var evaluatedEvents = []shared.EventType{
+	shared.EventLessonCompleted,
+	shared.EventCourseCompleted,
+	shared.EventFollowerGained,
+}
+
+// service implements the achievement and badge business logic
+type service struct {
+	repo     Repository
+	userRepo user.Repository
+}
+
+// NewService creates a new achievements service
+func NewService(repo Repository, userRepo user.Repository) Service {
+	return &service{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// Subscribe registers the evaluator for the events that change metrics
+func (s *service) Subscribe(bus shared.EventBus) {
+	for _, eventType := range evaluatedEvents {
+		bus.Subscribe(eventType, s.handleEvent)
+	}
+}
+
+// handleEvent evaluates the criteria for the user of an event
+func (s *service) handleEvent(event shared.Event) error {
+	if err := s.evaluate(event.UserID); err != nil {
+		return fmt.Errorf("failed to evaluate achievements of user %s: %w", event.UserID, err)
+	}
+	return nil
+}
+
+// evaluate compares the metrics of the user with every definition and grants the met ones.
+// Definitions the user already has are skipped.
+func (s *service) evaluate(userID uuid.UUID) error {
+	metrics, err := s.repo.GetMetrics(userID)
+	if err != nil {
+		return err
+	}
+
+	for _, kind := range Kinds {
+		definitions, err := s.repo.ListDefinitions(kind)
+		if err != nil {
+			return err
+		}
+		for i := range definitions {
+			definition := &definitions[i]
+			if !definition.Met(metrics) {
+				continue
+			}
+			// Grants are unique per user and definition, so concurrent evaluations grant once
+			if err := s.repo.Grant(userID, definition); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListDefinitions returns the catalog of a kind to admins
+func (s *service) ListDefinitions(actorID uuid.UUID, kind Kind) ([]Definition, error) {
+	if err := s.authorize(actorID, kind); err != nil {
+		return nil, err
+	}
+
+	definitions, err := s.repo.ListDefinitions(kind)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return definitions, nil
+}
+
+// GetDefinition returns a definition of the catalog to admins
+func (s *service) GetDefinition(actorID uuid.UUID, kind Kind, id uuid.UUID) (*Definition, error) {
+	if err := s.authorize(actorID, kind); err != nil {
+		return nil, err
+	}
+	return s.getDefinition(kind, id)
+}
+
+// CreateDefinition adds a definition to the catalog and grants it right away to the users meeting it
+func (s *service) CreateDefinition(actorID uuid.UUID, kind Kind, req *DefinitionRequest) (*Definition, error) {
+	if err := s.authorize(actorID, kind); err != nil {
+		return nil, err
+	}
+	if err := validateDefinition(req); err != nil {
+		return nil, err
+	}
+	if err := s.checkNameAvailable(kind, req.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	definition := &Definition{ID: uuid.New(), Kind: kind}
+	applyDefinition(definition, req)
+	if err := s.repo.CreateDefinition(definition); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return definition, nil
+}
+
+// UpdateDefinition changes a definition of the catalog. Users who already have it keep it,
+// users meeting the new criteria are granted it.
+func (s *service) UpdateDefinition(actorID uuid.UUID, kind Kind, id uuid.UUID, req *DefinitionRequest) (*Definition, error) {
+	if err := s.authorize(actorID, kind); err != nil {
+		return nil, err
+	}
+	if err := validateDefinition(req); err != nil {
+		return nil, err
+	}
+
+	definition, err := s.getDefinition(kind, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameAvailable(kind, req.Name, definition.ID); err != nil {
+		return nil, err
+	}
+
+	applyDefinition(definition, req)
+	if err := s.repo.UpdateDefinition(definition); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return definition, nil
+}
+
+// DeleteDefinition removes a definition from the catalog and from the users who have it
+func (s *service) DeleteDefinition(actorID uuid.UUID, kind Kind, id uuid.UUID) error {
+	if err := s.authorize(actorID, kind); err != nil {
+		return err
+	}
+	if _, err := s.getDefinition(kind, id); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteDefinition(kind, id); err != nil {
+		return shared.ErrDatabaseError
+	}
+	return nil
+}
+
+// authorize checks that the actor may edit the catalog of the kind
+func (s *service) authorize(actorID uuid.UUID, kind Kind) error {
+	if !slices.Contains(Kinds, kind) {
+		return shared.ErrInvalidInput
+	}
+	if actorID == uuid.Nil {
+		return shared.ErrUnauthorized
+	}
+
+	actor, err := s.userRepo.GetByID(actorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return shared.ErrUnauthorized
+		}
+		return shared.ErrDatabaseError
+	}
+	if !actor.Role.Can(shared.PermissionManageAchievements) {
+		return shared.ErrForbidden
+	}
+	return nil
+}
+
+// getDefinition loads a definition and maps a missing one to a not found error
+func (s *service) getDefinition(kind Kind, id uuid.UUID) (*Definition, error) {
+	definition, err := s.repo.GetDefinition(kind, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	return definition, nil
+}
+
+// checkNameAvailable rejects names used by another definition of the catalog
+func (s *service) checkNameAvailable(kind Kind, name string, id uuid.UUID) error {
+	existing, err := s.repo.GetDefinitionByName(kind, strings.TrimSpace(name))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return shared.ErrDatabaseError
+	}
+	if existing.ID != id {
+		return shared.NewAPIError(409, fmt.Sprintf("A %s with this name already exists", kind))
+	}
+	return nil
+}
+
+// validateDefinition checks the fields of a definition request
+func validateDefinition(req *DefinitionRequest) error {
+	if req == nil || strings.TrimSpace(req.Name) == "" || req.Metric == "" {
+		return shared.ErrMissingFields
+	}
+	if !req.Metric.Valid() {
+		return shared.NewAPIError(400, "Invalid metric. Must be one of: lessons_completed, courses_completed, xp, level, followers")
+	}
+	if req.Threshold < 1 {
+		return shared.NewAPIError(400, "Threshold must be at least 1")
+	}
+	return nil
+}
+
+// applyDefinition copies the fields of a request to a definition
+func applyDefinition(definition *Definition, req *DefinitionRequest) {
+	definition.Name = strings.TrimSpace(req.Name)
+	definition.Description = strings.TrimSpace(req.Description)
+	definition.Icon = strings.TrimSpace(req.Icon)
+	definition.Metric = req.Metric
+	definition.Threshold = req.Threshold
+}
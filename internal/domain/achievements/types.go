@@ -0,0 +1,68 @@
+package achievements
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind distinguishes the two catalogs, achievements and badges
+type Kind string
+
+// Catalog kinds
+const (
+	KindAchievement Kind = "achievement"
+	KindBadge       Kind = "badge"
+)
+
+// Kinds lists every catalog kind
+var Kinds = []Kind{KindAchievement, KindBadge}
+
+// Metric is a user statistic criteria are evaluated against
+type Metric string
+
+// Metrics criteria can use
+const (
+	MetricLessonsCompleted Metric = "lessons_completed"
+	MetricCoursesCompleted Metric = "courses_completed"
+	MetricXP               Metric = "xp"
+	MetricLevel            Metric = "level"
+	MetricFollowers        Metric = "followers"
+)
+
+// Metrics lists every metric
+var Metrics = []Metric{MetricLessonsCompleted, MetricCoursesCompleted, MetricXP, MetricLevel, MetricFollowers}
+
+// Valid reports whether the metric is one of the known metrics
+func (m Metric) Valid() bool {
+	return slices.Contains(Metrics, m)
+}
+
+// Definition is an achievement or badge of the catalog. It is granted to a user
+// as soon as the metric of the user reaches the threshold.
+type Definition struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	Kind        Kind      `json:"kind" gorm:"-"`
+	Name        string    `json:"name" gorm:"type:varchar(255);not null"`
+	Description string    `json:"description" gorm:"type:text;not null"`
+	Icon        string    `json:"icon" gorm:"type:varchar(255);not null"`
+	Metric      Metric    `json:"metric" gorm:"type:varchar(64);not null"`
+	Threshold   int       `json:"threshold" gorm:"not null"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// Met reports whether the metrics of a user meet the criteria of the definition
+func (d *Definition) Met(metrics map[Metric]int) bool {
+	return metrics[d.Metric] >= d.Threshold
+}
+
+// DefinitionRequest represents the request of an admin to create or update a definition
+type DefinitionRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+	Icon        string `json:"icon"`
+	Metric      Metric `json:"metric" validate:"required"`
+	Threshold   int    `json:"threshold" validate:"required,min=1"`
+}
@@ -1,13 +1,107 @@
 package auth
 
 import (
+	"errors"
+	"time"
+
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
 )
 
+// ErrRefreshTokenRevoked is returned when rotating a refresh token that has already been revoked
+var ErrRefreshTokenRevoked = errors.New("refresh token has been revoked")
+
+// ErrUserTokenInvalid is returned when a one-time token does not exist, was already used or has expired
+var ErrUserTokenInvalid = errors.New("token is invalid or expired")
+
 // Repository defines the interface for authentication data operations
 type Repository interface {
 	// User operations for authentication
 	GetUserByEmail(email string) (*shared.User, error)
 	CreateUser(user *shared.User) error
 	UserExists(email string) (bool, error)
+	// WithTx returns the repository bound to a transaction of a shared.UnitOfWork
+	WithTx(tx shared.Tx) Repository
+}
+
+// RefreshTokenRepository defines the interface for refresh token storage
+type RefreshTokenRepository interface {
+	CreateRefreshToken(token *RefreshToken) error
+	GetRefreshToken(id uuid.UUID) (*RefreshToken, error)
+	// RotateRefreshToken revokes the old token, links it to next and stores next in one transaction.
+	// It returns ErrRefreshTokenRevoked if the old token was already revoked.
+	RotateRefreshToken(oldID uuid.UUID, next *RefreshToken) error
+	// RevokeFamily revokes every token issued for the same login
+	RevokeFamily(familyID uuid.UUID) error
+	// RevokeUserTokens revokes every token of the user
+	RevokeUserTokens(userID uuid.UUID) error
+}
+
+// UserTokenRepository defines the interface for one-time token storage
+type UserTokenRepository interface {
+	// CreateUserToken stores the token and invalidates earlier unused tokens of the same user and purpose
+	CreateUserToken(token *UserToken) error
+	// VerifyEmail consumes an email verification token and marks its user as verified in one transaction.
+	// It returns ErrUserTokenInvalid if the token cannot be used.
+	VerifyEmail(tokenHash string) error
+	// ResetPassword consumes a password reset token, sets the new password hash and
+	// revokes every refresh token of the user in one transaction.
+	// It returns ErrUserTokenInvalid if the token cannot be used.
+	ResetPassword(tokenHash, passwordHash string) error
+}
+
+// LoginThrottleRepository defines the interface for failed login tracking
+type LoginThrottleRepository interface {
+	GetLoginThrottle(scope, key string) (*LoginThrottle, error)
+	// RecordLoginFailure atomically counts a failure. The count starts over when the previous
+	// failure and lockout both ended longer than window ago.
+	RecordLoginFailure(scope, key string, window time.Duration) (*LoginThrottle, error)
+	// LockLogin locks the throttle until lockedUntil and records the event in one transaction
+	LockLogin(scope, key string, lockedUntil time.Time, event *LockoutEvent) error
+	ResetLoginFailures(scope, key string) error
+	GetLockoutEvents(limit, offset int) ([]LockoutEvent, int64, error)
+}
+
+// ErrTwoFactorCodeUsed is returned when a TOTP code of an already used time step is replayed
+var ErrTwoFactorCodeUsed = errors.New("two-factor code already used")
+
+// ErrRecoveryCodeInvalid is returned when a recovery code is unknown or already used
+var ErrRecoveryCodeInvalid = errors.New("invalid recovery code")
+
+// TwoFactorRepository defines the interface for two-factor authentication data
+type TwoFactorRepository interface {
+	GetTwoFactor(userID uuid.UUID) (*TwoFactor, error)
+	// SaveTwoFactor creates or replaces a pending enrollment
+	SaveTwoFactor(twoFactor *TwoFactor) error
+	// ConfirmTwoFactor enables 2FA and replaces the recovery codes in one transaction
+	ConfirmTwoFactor(userID uuid.UUID, step int64, codes []RecoveryCode) error
+	// UseTOTPStep records an accepted code, returning ErrTwoFactorCodeUsed for replays
+	UseTOTPStep(userID uuid.UUID, step int64) error
+	// UseRecoveryCode consumes a recovery code, returning ErrRecoveryCodeInvalid if there is none
+	UseRecoveryCode(userID uuid.UUID, codeHash string) error
+	DeleteTwoFactor(userID uuid.UUID) error
+}
+
+// OIDCRepository defines the interface for external identities and pending authorization requests
+type OIDCRepository interface {
+	CreateOIDCState(state *OIDCState) error
+	// ConsumeOIDCState deletes and returns an unexpired state, or gorm.ErrRecordNotFound
+	ConsumeOIDCState(stateHash string) (*OIDCState, error)
+	GetIdentity(provider, subject string) (*UserIdentity, error)
+	CreateIdentity(identity *UserIdentity) error
+	// WithTx returns the repository bound to a transaction of a shared.UnitOfWork
+	WithTx(tx shared.Tx) OIDCRepository
+}
+
+// SessionRepository defines the interface for login sessions
+type SessionRepository interface {
+	CreateSession(session *Session) error
+	GetSession(id uuid.UUID) (*Session, error)
+	// GetUserSessions returns the sessions of the user that are neither revoked nor expired, newest first
+	GetUserSessions(userID uuid.UUID) ([]Session, error)
+	// TouchSession sets the last used time unless it was updated less than SessionTouchInterval ago
+	TouchSession(id uuid.UUID, usedAt time.Time) error
+	// RevokeSession revokes a session of the user and its refresh tokens in one transaction.
+	// It returns gorm.ErrRecordNotFound if the user has no such active session.
+	RevokeSession(userID, sessionID uuid.UUID) error
 }
@@ -1,8 +1,17 @@
 package auth
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"net/url"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
@@ -12,63 +21,297 @@ import (
 
 // Service defines the interface for authentication business logic
 type Service interface {
-	Login(req *LoginRequest) (*LoginResponse, error)
+	Login(req *LoginRequest) (*LoginResult, error)
+	VerifyTwoFactor(req *VerifyTwoFactorRequest) (*LoginResponse, error)
 	Register(req *RegisterRequest) (*LoginResponse, error)
 	RefreshToken(refreshToken string) (*LoginResponse, error)
-	ValidateToken(tokenString string) (uuid.UUID, string, error)
+	Logout(refreshToken string) error
+	LogoutAll(refreshToken string) error
+	VerifyEmail(token string) error
+	ResendVerification(email string) error
+	ForgotPassword(email string) error
+	ResetPassword(req *ResetPasswordRequest) error
+	ValidateToken(tokenString string) (*TokenClaims, error)
+	JWKS() JSONWebKeySet
+	GetLockoutEvents(actorID uuid.UUID, page, limit int) (*LockoutEventsPage, error)
+	EnrollTwoFactor(userID uuid.UUID) (*TwoFactorEnrollment, error)
+	ConfirmTwoFactor(userID uuid.UUID, code string) ([]string, error)
+	DisableTwoFactor(userID uuid.UUID, req *DisableTwoFactorRequest) error
+	GetSessions(userID, currentSessionID uuid.UUID) ([]Session, error)
+	RevokeSession(userID, sessionID uuid.UUID) error
+	StartOIDCLogin(ctx context.Context, req *OIDCAuthorizeRequest) (*OIDCAuthorization, error)
+	CompleteOIDCLogin(ctx context.Context, req *OIDCCallbackRequest) (*LoginResult, error)
 }
 
 // service implements the authentication business logic
 type service struct {
-	authRepo     Repository
-	userRepo     user.Repository
-	tokenGen     TokenGenerator
-	passwordHash shared.PasswordHasher
+	config           Config
+	uow              shared.UnitOfWork
+	authRepo         Repository
+	userRepo         user.Repository
+	refreshTokenRepo RefreshTokenRepository
+	sessionRepo      SessionRepository
+	userTokenRepo    UserTokenRepository
+	throttleRepo     LoginThrottleRepository
+	twoFactorRepo    TwoFactorRepository
+	oidcRepo         OIDCRepository
+	tokenGen         TokenGenerator
+	passwordHash     shared.PasswordHasher
+	mailer           shared.Mailer
+	totp             TOTPProvider
+	secretCipher     SecretCipher
+	oidcProviders    map[string]OIDCProvider
+	// dummyPasswordHash is compared against for unknown emails so they take as long as wrong passwords
+	dummyPasswordHash string
 }
 
 // NewService creates a new authentication service
 func NewService(
+	config Config,
+	uow shared.UnitOfWork,
 	authRepo Repository,
 	userRepo user.Repository,
+	refreshTokenRepo RefreshTokenRepository,
+	sessionRepo SessionRepository,
+	userTokenRepo UserTokenRepository,
+	throttleRepo LoginThrottleRepository,
+	twoFactorRepo TwoFactorRepository,
+	oidcRepo OIDCRepository,
 	tokenGen TokenGenerator,
 	passwordHash shared.PasswordHasher,
+	mailer shared.Mailer,
+	totp TOTPProvider,
+	secretCipher SecretCipher,
+	oidcProviders map[string]OIDCProvider,
 ) Service {
+	dummyPasswordHash, _ := passwordHash.HashPassword(uuid.NewString())
 	return &service{
-		authRepo:     authRepo,
-		userRepo:     userRepo,
-		tokenGen:     tokenGen,
-		passwordHash: passwordHash,
+		config:            config,
+		uow:               uow,
+		authRepo:          authRepo,
+		userRepo:          userRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		sessionRepo:       sessionRepo,
+		userTokenRepo:     userTokenRepo,
+		throttleRepo:      throttleRepo,
+		twoFactorRepo:     twoFactorRepo,
+		oidcRepo:          oidcRepo,
+		tokenGen:          tokenGen,
+		passwordHash:      passwordHash,
+		mailer:            mailer,
+		totp:              totp,
+		secretCipher:      secretCipher,
+		oidcProviders:     oidcProviders,
+		dummyPasswordHash: dummyPasswordHash,
 	}
 }
 
-// Login authenticates a user and returns tokens
-func (s *service) Login(req *LoginRequest) (*LoginResponse, error) {
+// Login authenticates a user and returns tokens, or a challenge when 2FA is enabled
+func (s *service) Login(req *LoginRequest) (*LoginResult, error) {
 	// Validate input
 	if req.Email == "" || req.Password == "" {
 		return nil, shared.ErrMissingFields
 	}
 
+	// Reject attempts while the account or the client is locked out
+	accountKey := strings.ToLower(strings.TrimSpace(req.Email))
+	if err := s.checkLoginLock(ThrottleScopeAccount, accountKey); err != nil {
+		return nil, err
+	}
+	if err := s.checkLoginLock(ThrottleScopeIP, req.IPAddress); err != nil {
+		return nil, err
+	}
+
 	// Get user by email
 	user, err := s.authRepo.GetUserByEmail(req.Email)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, shared.ErrInvalidCredentials
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrDatabaseError
 		}
-		return nil, shared.ErrDatabaseError
+		// Unknown emails cost a password comparison too, so timing does not reveal them
+		s.passwordHash.ComparePassword(req.Password, s.dummyPasswordHash)
+		if err := s.recordFailedAttempt(accountAttempt(req, accountKey, s.config.Lockout, nil)); err != nil {
+			return nil, err
+		}
+		return nil, shared.ErrInvalidCredentials
 	}
 
 	// Verify password
 	if !s.passwordHash.ComparePassword(req.Password, user.Password) {
+		if err := s.recordFailedAttempt(accountAttempt(req, accountKey, s.config.Lockout, &user.ID)); err != nil {
+			return nil, err
+		}
 		return nil, shared.ErrInvalidCredentials
 	}
 
-	// Generate tokens
-	tokens, err := s.tokenGen.GenerateToken(user.ID, user.Role)
+	// A successful login clears the failures of the account, not of the client
+	if err := s.throttleRepo.ResetLoginFailures(ThrottleScopeAccount, accountKey); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return s.completeLogin(user, req.IPAddress, req.UserAgent)
+}
+
+// completeLogin starts a session for an authenticated user, or returns a challenge when 2FA is enabled
+func (s *service) completeLogin(user *shared.User, ipAddress, userAgent string) (*LoginResult, error) {
+	// With 2FA enabled the first factor only earns a challenge for the second step
+	twoFactor, err := s.twoFactorRepo.GetTwoFactor(user.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, shared.ErrDatabaseError
+	}
+	if err == nil && twoFactor.Enabled() {
+		expiresAt := time.Now().Add(s.config.TwoFactorChallengeTTL)
+		challengeToken, err := s.tokenGen.GenerateChallengeToken(user.ID, string(user.Role), s.config.TwoFactorChallengeTTL)
+		if err != nil {
+			return nil, shared.ErrTokenGeneration
+		}
+		return &LoginResult{Challenge: &TwoFactorChallenge{ChallengeToken: challengeToken, ExpiresAt: expiresAt}}, nil
+	}
+
+	// Generate tokens for a new login
+	tokens, err := s.startSession(user, ipAddress, userAgent)
 	if err != nil {
-		return nil, shared.ErrTokenGeneration
+		return nil, err
+	}
+	return &LoginResult{Tokens: tokens}, nil
+}
+
+// VerifyTwoFactor completes a login challenge with a TOTP code or a recovery code
+func (s *service) VerifyTwoFactor(req *VerifyTwoFactorRequest) (*LoginResponse, error) {
+	if req.ChallengeToken == "" || req.Code == "" {
+		return nil, shared.ErrMissingFields
 	}
 
-	return tokens, nil
+	claims, err := s.tokenGen.ParseToken(req.ChallengeToken, TokenTypeTwoFactorChallenge)
+	if err != nil {
+		return nil, shared.NewAPIError(401, "Invalid or expired challenge token")
+	}
+
+	// Codes are short, so guessing them is throttled like passwords
+	userKey := claims.UserID.String()
+	if err := s.checkLoginLock(ThrottleScopeTwoFactor, userKey); err != nil {
+		return nil, err
+	}
+	if err := s.checkLoginLock(ThrottleScopeIP, req.IPAddress); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByID(claims.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrUnauthorized
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	twoFactor, err := s.getEnabledTwoFactor(user.ID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+
+	if err := s.checkSecondFactor(twoFactor, req.Code); err != nil {
+		if err != errInvalidSecondFactor {
+			return nil, err
+		}
+		if err := s.recordFailedAttempt(failedAttempt{
+			scope:     ThrottleScopeTwoFactor,
+			key:       userKey,
+			threshold: s.config.Lockout.AccountThreshold,
+			userID:    &user.ID,
+			ipAddress: req.IPAddress,
+			userAgent: req.UserAgent,
+		}); err != nil {
+			return nil, err
+		}
+		return nil, shared.NewAPIError(401, "Invalid two-factor code")
+	}
+
+	if err := s.throttleRepo.ResetLoginFailures(ThrottleScopeTwoFactor, userKey); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return s.startSession(user, req.IPAddress, req.UserAgent)
+}
+
+// checkLoginLock returns ErrTooManyLoginAttempts while logins of the scope key are locked
+func (s *service) checkLoginLock(scope, key string) error {
+	if key == "" {
+		return nil
+	}
+	throttle, err := s.throttleRepo.GetLoginThrottle(scope, key)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return shared.ErrDatabaseError
+	}
+	if throttle.LockedUntil != nil && throttle.LockedUntil.After(time.Now()) {
+		return shared.ErrTooManyLoginAttempts
+	}
+	return nil
+}
+
+// failedAttempt describes a failed login step of a subject, such as an account, and its client
+type failedAttempt struct {
+	scope     string
+	key       string
+	threshold int
+	userID    *uuid.UUID
+	ipAddress string
+	userAgent string
+}
+
+// accountAttempt describes a failed password check
+func accountAttempt(req *LoginRequest, accountKey string, policy LockoutPolicy, userID *uuid.UUID) failedAttempt {
+	return failedAttempt{
+		scope:     ThrottleScopeAccount,
+		key:       accountKey,
+		threshold: policy.AccountThreshold,
+		userID:    userID,
+		ipAddress: req.IPAddress,
+		userAgent: req.UserAgent,
+	}
+}
+
+// recordFailedAttempt counts a failure for the subject and the client, locking them
+// out once their thresholds are reached
+func (s *service) recordFailedAttempt(attempt failedAttempt) error {
+	if err := s.countLoginFailure(attempt, attempt.scope, attempt.key, attempt.threshold); err != nil {
+		return err
+	}
+	if attempt.ipAddress != "" {
+		if err := s.countLoginFailure(attempt, ThrottleScopeIP, attempt.ipAddress, s.config.Lockout.IPThreshold); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countLoginFailure counts a failure in one scope and locks the key when needed
+func (s *service) countLoginFailure(attempt failedAttempt, scope, key string, threshold int) error {
+	throttle, err := s.throttleRepo.RecordLoginFailure(scope, key, s.config.Lockout.FailureWindow)
+	if err != nil {
+		return shared.ErrDatabaseError
+	}
+
+	lockout := s.config.Lockout.LockoutDuration(throttle.Failures, threshold)
+	if lockout == 0 {
+		return nil
+	}
+	lockedUntil := time.Now().Add(lockout)
+	event := &LockoutEvent{
+		ID:          uuid.New(),
+		Scope:       scope,
+		Key:         key,
+		UserID:      attempt.userID,
+		IPAddress:   attempt.ipAddress,
+		UserAgent:   attempt.userAgent,
+		Failures:    throttle.Failures,
+		LockedUntil: lockedUntil,
+	}
+	if err := s.throttleRepo.LockLogin(scope, key, lockedUntil, event); err != nil {
+		return shared.ErrDatabaseError
+	}
+	return nil
 }
 
 // Register creates a new user and returns tokens
@@ -79,11 +322,13 @@ func (s *service) Register(req *RegisterRequest) (*LoginResponse, error) {
 		req.Role == "" || req.Location == "" {
 		return nil, shared.ErrMissingFields
 	}
+	if err := shared.ValidatePassword(req.Password); err != nil {
+		return nil, err
+	}
 
 	// Validate role
-	validRoles := []string{"student", "tutor", "admin"}
-	if !slices.Contains(validRoles, req.Role) {
-		return nil, shared.NewAPIError(400, "Invalid role. Must be one of: student, tutor, admin")
+	if err := validateRegistrationRole(req.Role); err != nil {
+		return nil, err
 	}
 
 	// Check if user already exists
@@ -110,11 +355,6 @@ func (s *service) Register(req *RegisterRequest) (*LoginResponse, error) {
 		Location: req.Location,
 	}
 
-	// Save user to database
-	if err := s.authRepo.CreateUser(newUser); err != nil {
-		return nil, shared.ErrDatabaseError
-	}
-
 	// Create user info
 	userInfo := &user.UserInfo{
 		ID:        uuid.New(),
@@ -127,87 +367,865 @@ func (s *service) Register(req *RegisterRequest) (*LoginResponse, error) {
 		Phone:     "", // Default empty phone
 	}
 
-	if err := s.userRepo.CreateUserInfo(userInfo); err != nil {
-		// Rollback user creation
-		s.userRepo.Delete(newUser.ID)
+	// Save user, profile and initial stats to database
+	if err := s.createAccount(newUser, userInfo, nil); err != nil {
+		return nil, err
+	}
+
+	// Registration succeeds even if the email cannot be sent, the user can ask for another one
+	_ = s.sendVerificationEmail(newUser)
+
+	// Generate tokens for a new login
+	return s.startSession(newUser, req.IPAddress, req.UserAgent)
+}
+
+// createAccount stores a new user with its profile, initial stats and, for accounts created
+// through an identity provider, the linked identity in one transaction
+func (s *service) createAccount(newUser *shared.User, userInfo *user.UserInfo, identity *UserIdentity) error {
+	err := s.uow.Do(func(tx shared.Tx) error {
+		if err := s.authRepo.WithTx(tx).CreateUser(newUser); err != nil {
+			return err
+		}
+		userRepo := s.userRepo.WithTx(tx)
+		if err := userRepo.CreateUserInfo(userInfo); err != nil {
+			return err
+		}
+		if err := userRepo.CreateUserStats(user.NewUserStats(newUser.ID)); err != nil {
+			return err
+		}
+		if identity != nil {
+			return s.oidcRepo.WithTx(tx).CreateIdentity(identity)
+		}
+		return nil
+	})
+	if err != nil {
+		return shared.ErrDatabaseError
+	}
+	return nil
+}
+
+// validateRegistrationRole checks the role a new account asks for, admins are only appointed by other admins
+func validateRegistrationRole(role shared.Role) error {
+	if !slices.Contains(shared.RegistrationRoles, role) {
+		return shared.NewAPIError(400, "Invalid role. Must be one of: student, tutor")
+	}
+	return nil
+}
+
+// RefreshToken rotates a refresh token and returns a new token pair.
+// Presenting a token that was already rotated revokes every token of its login.
+func (s *service) RefreshToken(refreshToken string) (*LoginResponse, error) {
+	stored, err := s.getRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.RevokedAt != nil {
+		// A revoked token is being replayed: the token was stolen or leaked
+		if err := s.refreshTokenRepo.RevokeFamily(stored.FamilyID); err != nil {
+			return nil, shared.ErrDatabaseError
+		}
+		return nil, shared.ErrInvalidCredentials
+	}
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, shared.ErrInvalidCredentials
+	}
+
+	// Use the current role so role changes apply on the next refresh
+	u, err := s.userRepo.GetByID(stored.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrInvalidCredentials
+		}
 		return nil, shared.ErrDatabaseError
 	}
 
-	// Generate tokens
-	tokens, err := s.tokenGen.GenerateToken(newUser.ID, newUser.Role)
+	next := s.newRefreshToken(u.ID, stored.FamilyID)
+	tokens, err := s.tokenGen.GenerateToken(u.ID, string(u.Role), stored.FamilyID, next.ID)
 	if err != nil {
 		return nil, shared.ErrTokenGeneration
 	}
 
+	if err := s.refreshTokenRepo.RotateRefreshToken(stored.ID, next); err != nil {
+		if errors.Is(err, ErrRefreshTokenRevoked) {
+			// Another request rotated the same token concurrently
+			if err := s.refreshTokenRepo.RevokeFamily(stored.FamilyID); err != nil {
+				return nil, shared.ErrDatabaseError
+			}
+			return nil, shared.ErrInvalidCredentials
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	// Refreshing counts as using the session, failing to record it does not fail the refresh
+	_ = s.sessionRepo.TouchSession(stored.FamilyID, time.Now())
+
 	return tokens, nil
 }
 
-// RefreshToken generates new tokens using refresh token
-func (s *service) RefreshToken(refreshToken string) (*LoginResponse, error) {
-	// Validate input
-	if refreshToken == "" {
-		return nil, shared.ErrMissingFields
+// Logout revokes the refresh token together with all of its rotations
+func (s *service) Logout(refreshToken string) error {
+	stored, err := s.getRefreshToken(refreshToken)
+	if err != nil {
+		return err
 	}
 
-	// Parse and validate refresh token
-	claims, err := s.tokenGen.ParseToken(refreshToken)
+	if err := s.refreshTokenRepo.RevokeFamily(stored.FamilyID); err != nil {
+		return shared.ErrDatabaseError
+	}
+
+	return nil
+}
+
+// LogoutAll revokes every refresh token of the user owning the refresh token
+func (s *service) LogoutAll(refreshToken string) error {
+	stored, err := s.getRefreshToken(refreshToken)
 	if err != nil {
-		return nil, shared.ErrInvalidCredentials
+		return err
 	}
 
-	// Extract user ID and role from token
-	userIDStr, ok := claims["sub"].(string)
-	if !ok {
-		return nil, shared.ErrInvalidCredentials
+	if err := s.refreshTokenRepo.RevokeUserTokens(stored.UserID); err != nil {
+		return shared.ErrDatabaseError
 	}
 
-	role, ok := claims["role"].(string)
-	if !ok {
+	return nil
+}
+
+// VerifyEmail marks the user owning the verification token as verified
+func (s *service) VerifyEmail(token string) error {
+	if token == "" {
+		return shared.ErrMissingFields
+	}
+
+	if err := s.userTokenRepo.VerifyEmail(hashUserToken(token)); err != nil {
+		if errors.Is(err, ErrUserTokenInvalid) {
+			return shared.NewAPIError(400, "Invalid or expired verification token")
+		}
+		return shared.ErrDatabaseError
+	}
+
+	return nil
+}
+
+// ResendVerification sends a new verification email.
+// Unknown and already verified emails are ignored so the endpoint does not reveal which accounts exist.
+func (s *service) ResendVerification(email string) error {
+	if email == "" {
+		return shared.ErrMissingFields
+	}
+
+	u, err := s.authRepo.GetUserByEmail(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return shared.ErrDatabaseError
+	}
+	if u.IsVerified {
+		return nil
+	}
+
+	return s.sendVerificationEmail(u)
+}
+
+// ForgotPassword emails a password reset link.
+// Unknown emails are ignored so the endpoint does not reveal which accounts exist.
+func (s *service) ForgotPassword(email string) error {
+	if email == "" {
+		return shared.ErrMissingFields
+	}
+
+	u, err := s.authRepo.GetUserByEmail(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return shared.ErrDatabaseError
+	}
+
+	token, err := s.createUserToken(u.ID, TokenPurposePasswordReset, s.config.PasswordResetTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	body := "Your password reset code: " + token
+	if s.config.AppURL != "" {
+		body = "Reset your password by opening this link:\n\n" +
+			s.config.AppURL + "/reset-password?token=" + url.QueryEscape(token)
+	}
+	body += "\n\nThe link expires in " + s.config.PasswordResetTokenTTL.String() +
+		". If you did not ask to reset your password, ignore this email."
+
+	if err := s.mailer.Send(&shared.EmailMessage{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body:    body,
+	}); err != nil {
+		return shared.ErrInternalServer
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password with a reset token and logs the user out everywhere
+func (s *service) ResetPassword(req *ResetPasswordRequest) error {
+	if req == nil || req.Token == "" || req.NewPassword == "" {
+		return shared.ErrMissingFields
+	}
+	if err := shared.ValidatePassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.passwordHash.HashPassword(req.NewPassword)
+	if err != nil {
+		return shared.ErrInternalServer
+	}
+
+	if err := s.userTokenRepo.ResetPassword(hashUserToken(req.Token), hashedPassword); err != nil {
+		if errors.Is(err, ErrUserTokenInvalid) {
+			return shared.NewAPIError(400, "Invalid or expired reset token")
+		}
+		return shared.ErrDatabaseError
+	}
+
+	return nil
+}
+
+// ValidateToken validates a token and returns user ID and role
+func (s *service) ValidateToken(tokenString string) (*TokenClaims, error) {
+	if tokenString == "" {
+		return nil, shared.ErrMissingFields
+	}
+
+	// Refresh tokens must not be accepted as bearer tokens
+	claims, err := s.tokenGen.ParseToken(tokenString, TokenTypeAccess)
+	if err != nil || claims.SessionID == uuid.Nil {
 		return nil, shared.ErrInvalidCredentials
 	}
 
-	// Parse user ID
-	userID, err := uuid.Parse(userIDStr)
+	// Access tokens stop working as soon as their session is revoked
+	session, err := s.sessionRepo.GetSession(claims.SessionID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrInvalidCredentials
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if session.RevokedAt != nil || session.UserID != claims.UserID {
 		return nil, shared.ErrInvalidCredentials
 	}
 
-	// Generate new tokens
-	tokens, err := s.tokenGen.GenerateToken(userID, role)
+	now := time.Now()
+	if now.Sub(session.LastUsedAt) >= SessionTouchInterval {
+		_ = s.sessionRepo.TouchSession(session.ID, now)
+	}
+
+	return claims, nil
+}
+
+// GetSessions lists the active sessions of the user, marking the one the request uses
+func (s *service) GetSessions(userID, currentSessionID uuid.UUID) ([]Session, error) {
+	sessions, err := s.sessionRepo.GetUserSessions(userID)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == currentSessionID
+	}
+	return sessions, nil
+}
+
+// RevokeSession logs the user out of one session, including its access tokens
+func (s *service) RevokeSession(userID, sessionID uuid.UUID) error {
+	if err := s.sessionRepo.RevokeSession(userID, sessionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return shared.ErrNotFound
+		}
+		return shared.ErrDatabaseError
+	}
+	return nil
+}
+
+// JWKS returns the public keys other services use to verify issued tokens
+func (s *service) JWKS() JSONWebKeySet {
+	return s.tokenGen.PublicKeys()
+}
+
+// startSession records a new login of the user and issues its first token pair
+func (s *service) startSession(user *shared.User, ipAddress, userAgent string) (*LoginResponse, error) {
+	session := &Session{
+		ID:         uuid.New(),
+		UserID:     user.ID,
+		Device:     describeDevice(userAgent),
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		LastUsedAt: time.Now(),
+	}
+	if err := s.sessionRepo.CreateSession(session); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return s.issueTokens(user.ID, string(user.Role), session.ID)
+}
+
+// issueTokens generates a token pair of the session and stores its refresh token in the session's family
+func (s *service) issueTokens(userID uuid.UUID, role string, sessionID uuid.UUID) (*LoginResponse, error) {
+	refreshToken := s.newRefreshToken(userID, sessionID)
+
+	tokens, err := s.tokenGen.GenerateToken(userID, role, sessionID, refreshToken.ID)
 	if err != nil {
 		return nil, shared.ErrTokenGeneration
 	}
 
+	if err := s.refreshTokenRepo.CreateRefreshToken(refreshToken); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
 	return tokens, nil
 }
 
-// ValidateToken validates a token and returns user ID and role
-func (s *service) ValidateToken(tokenString string) (uuid.UUID, string, error) {
-	if tokenString == "" {
-		return uuid.Nil, "", shared.ErrMissingFields
+// newRefreshToken builds the record of a refresh token that is about to be issued
+func (s *service) newRefreshToken(userID, familyID uuid.UUID) *RefreshToken {
+	return &RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(s.tokenGen.RefreshTokenTTL()),
 	}
+}
 
-	claims, err := s.tokenGen.ParseToken(tokenString)
+// getRefreshToken verifies a refresh token and loads its stored record
+func (s *service) getRefreshToken(refreshToken string) (*RefreshToken, error) {
+	if refreshToken == "" {
+		return nil, shared.ErrMissingFields
+	}
+
+	// Access tokens must not be exchanged for new tokens
+	claims, err := s.tokenGen.ParseToken(refreshToken, TokenTypeRefresh)
+	if err != nil || claims.TokenID == uuid.Nil {
+		return nil, shared.ErrInvalidCredentials
+	}
+
+	stored, err := s.refreshTokenRepo.GetRefreshToken(claims.TokenID)
 	if err != nil {
-		return uuid.Nil, "", shared.ErrInvalidCredentials
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrInvalidCredentials
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if stored.UserID != claims.UserID {
+		return nil, shared.ErrInvalidCredentials
+	}
+
+	return stored, nil
+}
+
+// sendVerificationEmail issues a verification token for the user and emails it
+func (s *service) sendVerificationEmail(u *shared.User) error {
+	token, err := s.createUserToken(u.ID, TokenPurposeEmailVerification, s.config.VerificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	body := "Your email verification code: " + token
+	if s.config.AppURL != "" {
+		body = "Confirm your email address by opening this link:\n\n" +
+			s.config.AppURL + "/verify-email?token=" + url.QueryEscape(token)
+	}
+	body += "\n\nThe link expires in " + s.config.VerificationTokenTTL.String() + "."
+
+	if err := s.mailer.Send(&shared.EmailMessage{
+		To:      u.Email,
+		Subject: "Confirm your email address",
+		Body:    body,
+	}); err != nil {
+		return shared.ErrInternalServer
+	}
+
+	return nil
+}
+
+// createUserToken stores a new one-time token and returns its plain value
+func (s *service) createUserToken(userID uuid.UUID, purpose string, ttl time.Duration) (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", shared.ErrTokenGeneration
+	}
+
+	if err := s.userTokenRepo.CreateUserToken(&UserToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hashUserToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}); err != nil {
+		return "", shared.ErrDatabaseError
+	}
+
+	return token, nil
+}
+
+// randomToken returns 32 random bytes encoded for use in URLs
+func randomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// hashUserToken returns the stored form of a one-time token
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetLockoutEvents returns recent login lockouts to administrators
+func (s *service) GetLockoutEvents(actorID uuid.UUID, page, limit int) (*LockoutEventsPage, error) {
+	actor, err := s.userRepo.GetByID(actorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrUnauthorized
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if !actor.Role.Can(shared.PermissionViewSecurityEvents) {
+		return nil, shared.ErrForbidden
+	}
+
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultLockoutEventsLimit
+	}
+	if page < 1 || limit < 1 || limit > maxLockoutEventsLimit {
+		return nil, shared.ErrInvalidInput
+	}
+
+	events, total, err := s.throttleRepo.GetLockoutEvents(limit, (page-1)*limit)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return &LockoutEventsPage{
+		Events: events,
+		Page:   page,
+		Limit:  limit,
+		Total:  int(total),
+	}, nil
+}
+
+// EnrollTwoFactor starts 2FA setup with a new secret, replacing any pending one
+func (s *service) EnrollTwoFactor(userID uuid.UUID) (*TwoFactorEnrollment, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrUnauthorized
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if !slices.Contains(TwoFactorRoles, user.Role) {
+		return nil, shared.NewAPIError(403, "Two-factor authentication is available to tutors and admins")
+	}
+
+	if _, err := s.getEnabledTwoFactor(userID); err == nil {
+		return nil, shared.NewAPIError(409, "Two-factor authentication is already enabled")
+	} else if err != errTwoFactorNotEnabled {
+		return nil, err
+	}
+
+	secret, uri, err := s.totp.GenerateSecret(s.config.TwoFactorIssuer, user.Email)
+	if err != nil {
+		return nil, shared.ErrInternalServer
+	}
+	encrypted, err := s.secretCipher.Encrypt(secret)
+	if err != nil {
+		return nil, shared.ErrInternalServer
+	}
+	if err := s.twoFactorRepo.SaveTwoFactor(&TwoFactor{UserID: userID, Secret: encrypted}); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return &TwoFactorEnrollment{Secret: secret, OTPAuthURI: uri}, nil
+}
+
+// ConfirmTwoFactor enables a pending 2FA setup with a first code and returns the recovery codes
+func (s *service) ConfirmTwoFactor(userID uuid.UUID, code string) ([]string, error) {
+	if code == "" {
+		return nil, shared.ErrMissingFields
+	}
+
+	twoFactor, err := s.twoFactorRepo.GetTwoFactor(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.NewAPIError(409, "Two-factor authentication setup has not been started")
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if twoFactor.Enabled() {
+		return nil, shared.NewAPIError(409, "Two-factor authentication is already enabled")
+	}
+
+	step, err := s.validateTOTP(twoFactor, code)
+	if err != nil {
+		if err == errInvalidSecondFactor {
+			return nil, shared.NewAPIError(400, "Invalid two-factor code")
+		}
+		return nil, err
 	}
 
-	// Extract user ID and role from token
-	userIDStr, ok := claims["sub"].(string)
+	codes := make([]string, 0, RecoveryCodeCount)
+	recoveryCodes := make([]RecoveryCode, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, shared.ErrInternalServer
+		}
+		codes = append(codes, code)
+		recoveryCodes = append(recoveryCodes, RecoveryCode{
+			ID:       uuid.New(),
+			UserID:   userID,
+			CodeHash: hashUserToken(normalizeRecoveryCode(code)),
+		})
+	}
+
+	if err := s.twoFactorRepo.ConfirmTwoFactor(userID, step, recoveryCodes); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return codes, nil
+}
+
+// DisableTwoFactor turns 2FA off after checking the password and a current code
+func (s *service) DisableTwoFactor(userID uuid.UUID, req *DisableTwoFactorRequest) error {
+	if req.Password == "" || req.Code == "" {
+		return shared.ErrMissingFields
+	}
+
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return shared.ErrUnauthorized
+		}
+		return shared.ErrDatabaseError
+	}
+	twoFactor, err := s.getEnabledTwoFactor(userID)
+	if err != nil {
+		if err == errTwoFactorNotEnabled {
+			return shared.NewAPIError(409, "Two-factor authentication is not enabled")
+		}
+		return err
+	}
+
+	if !s.passwordHash.ComparePassword(req.Password, user.Password) {
+		return shared.NewAPIError(400, "Invalid password")
+	}
+	if err := s.checkSecondFactor(twoFactor, req.Code); err != nil {
+		if err == errInvalidSecondFactor {
+			return shared.NewAPIError(400, "Invalid two-factor code")
+		}
+		return err
+	}
+
+	if err := s.twoFactorRepo.DeleteTwoFactor(userID); err != nil {
+		return shared.ErrDatabaseError
+	}
+	return nil
+}
+
+// errTwoFactorNotEnabled and errInvalidSecondFactor are mapped to API errors by each caller
+var (
+	errTwoFactorNotEnabled = errors.New("two-factor authentication not enabled")
+	errInvalidSecondFactor = errors.New("invalid second factor")
+)
+
+// getEnabledTwoFactor returns the confirmed 2FA settings of a user or errTwoFactorNotEnabled
+func (s *service) getEnabledTwoFactor(userID uuid.UUID) (*TwoFactor, error) {
+	twoFactor, err := s.twoFactorRepo.GetTwoFactor(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errTwoFactorNotEnabled
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if !twoFactor.Enabled() {
+		return nil, errTwoFactorNotEnabled
+	}
+	return twoFactor, nil
+}
+
+// checkSecondFactor accepts a TOTP code or consumes a recovery code
+func (s *service) checkSecondFactor(twoFactor *TwoFactor, code string) error {
+	code = strings.TrimSpace(code)
+	if !isTOTPCode(code) {
+		err := s.twoFactorRepo.UseRecoveryCode(twoFactor.UserID, hashUserToken(normalizeRecoveryCode(code)))
+		if err != nil {
+			if errors.Is(err, ErrRecoveryCodeInvalid) {
+				return errInvalidSecondFactor
+			}
+			return shared.ErrDatabaseError
+		}
+		return nil
+	}
+
+	step, err := s.validateTOTP(twoFactor, code)
+	if err != nil {
+		return err
+	}
+	if err := s.twoFactorRepo.UseTOTPStep(twoFactor.UserID, step); err != nil {
+		if errors.Is(err, ErrTwoFactorCodeUsed) {
+			return errInvalidSecondFactor
+		}
+		return shared.ErrDatabaseError
+	}
+	return nil
+}
+
+// validateTOTP checks a TOTP code against the stored secret and returns its time step
+func (s *service) validateTOTP(twoFactor *TwoFactor, code string) (int64, error) {
+	secret, err := s.secretCipher.Decrypt(twoFactor.Secret)
+	if err != nil {
+		return 0, shared.ErrInternalServer
+	}
+	step, ok := s.totp.ValidateCode(secret, strings.TrimSpace(code), time.Now())
 	if !ok {
-		return uuid.Nil, "", shared.ErrInvalidCredentials
+		return 0, errInvalidSecondFactor
+	}
+	return step, nil
+}
+
+// isTOTPCode reports whether the code looks like a 6 digit TOTP code rather than a recovery code
+func isTOTPCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRecoveryCode returns a random recovery code formatted as xxxxx-xxxxx
+func newRecoveryCode() (string, error) {
+	raw := make([]byte, 7)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
 	}
+	code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
 
-	role, ok := claims["role"].(string)
+// normalizeRecoveryCode ignores case, spaces and dashes users may type differently
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+}
+
+// StartOIDCLogin creates the state, nonce and PKCE verifier of a login with an external provider
+// and returns the URL to send the user to
+func (s *service) StartOIDCLogin(ctx context.Context, req *OIDCAuthorizeRequest) (*OIDCAuthorization, error) {
+	provider, ok := s.oidcProviders[req.Provider]
 	if !ok {
-		return uuid.Nil, "", shared.ErrInvalidCredentials
+		return nil, shared.NewAPIError(404, "Unknown identity provider")
 	}
 
-	// Parse user ID
-	userID, err := uuid.Parse(userIDStr)
+	// Accounts created on first login follow the same role rules as registration
+	role := req.Role
+	if role == "" {
+		role = shared.RoleStudent
+	}
+	if err := validateRegistrationRole(role); err != nil {
+		return nil, err
+	}
+
+	state, err := randomToken()
+	if err != nil {
+		return nil, shared.ErrTokenGeneration
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return nil, shared.ErrTokenGeneration
+	}
+	codeVerifier, err := randomToken()
 	if err != nil {
-		return uuid.Nil, "", shared.ErrInvalidCredentials
+		return nil, shared.ErrTokenGeneration
+	}
+
+	authorizationURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, shared.NewAPIError(502, "Identity provider is unavailable")
+	}
+
+	if err := s.oidcRepo.CreateOIDCState(&OIDCState{
+		StateHash:    hashUserToken(state),
+		Provider:     req.Provider,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		Role:         role,
+		ExpiresAt:    time.Now().Add(OIDCStateTTL),
+	}); err != nil {
+		return nil, shared.ErrDatabaseError
 	}
 
-	return userID, role, nil
+	return &OIDCAuthorization{AuthorizationURL: authorizationURL, State: state}, nil
+}
+
+// CompleteOIDCLogin redeems the provider's authorization code and logs in the linked user,
+// linking or creating the account on first login
+func (s *service) CompleteOIDCLogin(ctx context.Context, req *OIDCCallbackRequest) (*LoginResult, error) {
+	provider, ok := s.oidcProviders[req.Provider]
+	if !ok {
+		return nil, shared.NewAPIError(404, "Unknown identity provider")
+	}
+	if req.Code == "" || req.State == "" {
+		return nil, shared.ErrMissingFields
+	}
+
+	// The state is single use, so a callback cannot be replayed
+	state, err := s.oidcRepo.ConsumeOIDCState(hashUserToken(req.State))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.NewAPIError(400, "Invalid or expired state")
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if state.Provider != req.Provider {
+		return nil, shared.NewAPIError(400, "Invalid or expired state")
+	}
+
+	identity, err := provider.Exchange(ctx, req.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		return nil, shared.NewAPIError(401, "Sign-in with the identity provider failed")
+	}
+
+	user, err := s.resolveIdentityUser(req.Provider, identity, state.Role)
+	if err != nil {
+		return nil, err
+	}
+	return s.completeLogin(user, req.IPAddress, req.UserAgent)
+}
+
+// resolveIdentityUser returns the user linked to the external identity. Unlinked identities are
+// linked to the account with the same verified email, or get a new account.
+func (s *service) resolveIdentityUser(provider string, identity *ExternalIdentity, role shared.Role) (*shared.User, error) {
+	linked, err := s.oidcRepo.GetIdentity(provider, identity.Subject)
+	if err == nil {
+		user, err := s.userRepo.GetByID(linked.UserID)
+		if err != nil {
+			return nil, shared.ErrDatabaseError
+		}
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, shared.ErrDatabaseError
+	}
+
+	if identity.Email == "" {
+		return nil, shared.NewAPIError(400, "The identity provider did not share an email address")
+	}
+
+	existing, err := s.authRepo.GetUserByEmail(identity.Email)
+	if err == nil {
+		// Only a provider that verified the email may sign in to an existing account
+		if !identity.EmailVerified {
+			return nil, shared.NewAPIError(409, "An account with this email already exists, sign in with your password")
+		}
+		if err := s.oidcRepo.CreateIdentity(newUserIdentity(existing.ID, provider, identity)); err != nil {
+			return nil, shared.ErrDatabaseError
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return s.createIdentityUser(provider, identity, role)
+}
+
+// createIdentityUser creates the account of a first login with an external provider
+func (s *service) createIdentityUser(provider string, identity *ExternalIdentity, role shared.Role) (*shared.User, error) {
+	// The account gets an unknown password, a password can be set with the reset flow
+	unusablePassword, err := randomToken()
+	if err != nil {
+		return nil, shared.ErrInternalServer
+	}
+	hashedPassword, err := s.passwordHash.HashPassword(unusablePassword)
+	if err != nil {
+		return nil, shared.ErrInternalServer
+	}
+
+	newUser := &shared.User{
+		ID:         uuid.New(),
+		Email:      identity.Email,
+		Password:   hashedPassword,
+		Role:       role,
+		IsVerified: identity.EmailVerified,
+		IsActive:   true,
+	}
+	userInfo := &user.UserInfo{
+		ID:        uuid.New(),
+		UserID:    newUser.ID,
+		FirstName: identity.FirstName,
+		LastName:  identity.LastName,
+	}
+	if err := s.createAccount(newUser, userInfo, newUserIdentity(newUser.ID, provider, identity)); err != nil {
+		return nil, err
+	}
+
+	if !newUser.IsVerified {
+		_ = s.sendVerificationEmail(newUser)
+	}
+	return newUser, nil
+}
+
+// newUserIdentity links an external identity to a user
+func newUserIdentity(userID uuid.UUID, provider string, identity *ExternalIdentity) *UserIdentity {
+	return &UserIdentity{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Provider: provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}
+}
+
+// describeDevice summarizes a user agent as "<browser> on <platform>" for the sessions list
+func describeDevice(userAgent string) string {
+	if userAgent == "" {
+		return "Unknown device"
+	}
+
+	browsers := []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+		{"curl/", "curl"},
+		{"okhttp", "Android app"},
+		{"CFNetwork", "iOS app"},
+	}
+	platforms := []struct{ token, name string }{
+		{"Android", "Android"},
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+
+	browser := "Unknown browser"
+	for _, b := range browsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	for _, p := range platforms {
+		if strings.Contains(userAgent, p.token) {
+			return browser + " on " + p.name
+		}
+	}
+	return browser
 }
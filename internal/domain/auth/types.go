@@ -1,21 +1,49 @@
 package auth
 
-import "github.com/google/uuid"
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
+)
 
 // LoginRequest represents the login request
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=6"`
+
+	// Client the attempt comes from, used for brute-force protection
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 // RegisterRequest represents the registration request
 type RegisterRequest struct {
-	FirstName string `json:"first_name" validate:"required"`
-	LastName  string `json:"last_name" validate:"required"`
-	Email     string `json:"email" validate:"required,email"`
-	Password  string `json:"password" validate:"required,min=6"`
-	Role      string `json:"role" validate:"required,oneof=student tutor admin"`
-	Location  string `json:"location" validate:"required"`
+	FirstName string      `json:"first_name" validate:"required"`
+	LastName  string      `json:"last_name" validate:"required"`
+	Email     string      `json:"email" validate:"required,email"`
+	Password  string      `json:"password" validate:"required,min=6"`
+	Role      shared.Role `json:"role" validate:"required,oneof=student tutor"`
+	Location  string      `json:"location" validate:"required"`
+
+	// Client the registration comes from, recorded on the first session
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// LoginResult is the outcome of a successful password check: either the tokens,
+// or a challenge to complete with a second factor when 2FA is enabled
+type LoginResult struct {
+	Tokens    *LoginResponse
+	Challenge *TwoFactorChallenge
+}
+
+// TwoFactorChallenge is returned by Login instead of tokens when 2FA is enabled
+type TwoFactorChallenge struct {
+	ChallengeToken string    `json:"challenge_token"`
+	ExpiresAt      time.Time `json:"expires_at"`
 }
 
 // LoginResponse represents the authentication response
@@ -29,9 +57,366 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
 }
 
+// RefreshToken is the server-side record of an issued refresh token.
+// Every refresh rotates the token; all rotations of one login share a family.
+type RefreshToken struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	UserID     uuid.UUID  `json:"user_id" gorm:"type:uuid;not null"`
+	FamilyID   uuid.UUID  `json:"family_id" gorm:"type:uuid;not null"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	ReplacedBy *uuid.UUID `json:"replaced_by" gorm:"type:uuid"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// Session is a login on one device. Its ID is the family ID of the login's refresh tokens
+// and the sid claim of its access tokens.
+type Session struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	UserID     uuid.UUID  `json:"user_id" gorm:"type:uuid;not null"`
+	Device     string     `json:"device"`
+	IPAddress  string     `json:"ip_address"`
+	UserAgent  string     `json:"user_agent"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	LastUsedAt time.Time  `json:"last_used_at" gorm:"not null"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	// Current marks the session of the request listing the sessions
+	Current bool `json:"current" gorm:"-"`
+}
+
+// SessionTouchInterval limits how often requests update the last used time of their session
+const SessionTouchInterval = time.Minute
+
+// Config holds settings of the authentication flows
+type Config struct {
+	// AppURL is the frontend base URL used in links sent by email
+	AppURL string
+	// VerificationTokenTTL is how long an email verification link stays valid
+	VerificationTokenTTL time.Duration
+	// PasswordResetTokenTTL is how long a password reset link stays valid
+	PasswordResetTokenTTL time.Duration
+	// Lockout configures brute-force protection of the login endpoint
+	Lockout LockoutPolicy
+	// TwoFactorIssuer is the name authenticator apps show for enrolled accounts
+	TwoFactorIssuer string
+	// TwoFactorChallengeTTL is how long the second step of a login may take
+	TwoFactorChallengeTTL time.Duration
+}
+
+// Scopes failed logins are counted in
+const (
+	ThrottleScopeAccount = "account"
+	ThrottleScopeIP      = "ip"
+)
+
+// LockoutPolicy configures failed login tracking and temporary lockouts
+type LockoutPolicy struct {
+	// AccountThreshold is the number of failures per email before logins to it are locked
+	AccountThreshold int
+	// IPThreshold is the number of failures per client IP before logins from it are locked
+	IPThreshold int
+	// BaseLockout is the first lockout duration, doubled for every further failure up to MaxLockout
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+	// FailureWindow resets the failure count after this long without failures or lockouts
+	FailureWindow time.Duration
+}
+
+// DefaultLockoutPolicy returns the lockout settings used unless configured otherwise
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		AccountThreshold: 5,
+		IPThreshold:      50,
+		BaseLockout:      time.Minute,
+		MaxLockout:       time.Hour,
+		FailureWindow:    15 * time.Minute,
+	}
+}
+
+// LockoutDuration returns how long logins are locked after the given number of failures,
+// or zero while the failures are below the threshold
+func (p LockoutPolicy) LockoutDuration(failures, threshold int) time.Duration {
+	if threshold <= 0 || failures < threshold {
+		return 0
+	}
+	lockout := p.BaseLockout
+	for i := threshold; i < failures && lockout < p.MaxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > p.MaxLockout {
+		lockout = p.MaxLockout
+	}
+	return lockout
+}
+
+// LoginThrottle counts recent failed logins of an account or a client IP
+type LoginThrottle struct {
+	Scope         string     `json:"scope" gorm:"primaryKey"`
+	Key           string     `json:"key" gorm:"primaryKey"`
+	Failures      int        `json:"failures" gorm:"not null"`
+	LastFailureAt time.Time  `json:"last_failure_at" gorm:"not null"`
+	LockedUntil   *time.Time `json:"locked_until"`
+}
+
+// LockoutEvent records that an account or a client IP was locked out
+type LockoutEvent struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	Scope       string     `json:"scope" gorm:"not null"`
+	Key         string     `json:"key" gorm:"not null"`
+	UserID      *uuid.UUID `json:"user_id" gorm:"type:uuid"`
+	IPAddress   string     `json:"ip_address"`
+	UserAgent   string     `json:"user_agent"`
+	Failures    int        `json:"failures" gorm:"not null"`
+	LockedUntil time.Time  `json:"locked_until" gorm:"not null"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// Page sizes of the lockout event list
+const (
+	defaultLockoutEventsLimit = 20
+	maxLockoutEventsLimit     = 100
+)
+
+// LockoutEventsPage is a page of lockout events, newest first
+type LockoutEventsPage struct {
+	Events []LockoutEvent `json:"events"`
+	Page   int            `json:"page"`
+	Limit  int            `json:"limit"`
+	Total  int            `json:"total"`
+}
+
+// Purposes of one-time tokens sent to users by email
+const (
+	TokenPurposeEmailVerification = "email_verification"
+	TokenPurposePasswordReset     = "password_reset"
+)
+
+// UserToken is a single-use token sent to a user by email. Only its SHA-256 hash is stored.
+type UserToken struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	UserID    uuid.UUID  `json:"user_id" gorm:"type:uuid;not null"`
+	Purpose   string     `json:"purpose" gorm:"not null"`
+	TokenHash string     `json:"-" gorm:"not null"`
+	ExpiresAt time.Time  `json:"expires_at" gorm:"not null"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// VerifyEmailRequest represents the email verification request
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ResetPasswordRequest represents the password reset request
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=6"`
+}
+
+// TokenType is the purpose a token was issued for
+type TokenType string
+
+const (
+	// TokenTypeAccess authenticates API requests
+	TokenTypeAccess TokenType = "access"
+	// TokenTypeRefresh can only be exchanged for a new token pair
+	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeTwoFactorChallenge proves the password step of a login with 2FA enabled
+	TokenTypeTwoFactorChallenge TokenType = "2fa_challenge"
+)
+
+// ErrUnexpectedTokenType is returned when a valid token is used for another purpose than it was issued for
+var ErrUnexpectedTokenType = errors.New("unexpected token type")
+
+// TokenClaims represents the verified claims of a token
+type TokenClaims struct {
+	UserID    uuid.UUID
+	Role      string
+	Type      TokenType
+	TokenID   uuid.UUID // jti, only set on refresh tokens
+	SessionID uuid.UUID // sid, only set on access tokens
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
 // TokenGenerator defines the interface for token operations
 type TokenGenerator interface {
-	GenerateToken(userID uuid.UUID, role string) (*LoginResponse, error)
-	ParseToken(tokenString string) (map[string]interface{}, error)
-	ValidateToken(tokenString string) error
+	// GenerateToken issues an access token of the session and a refresh token whose jti is refreshTokenID
+	GenerateToken(userID uuid.UUID, role string, sessionID, refreshTokenID uuid.UUID) (*LoginResponse, error)
+	// RefreshTokenTTL returns how long issued refresh tokens stay valid
+	RefreshTokenTTL() time.Duration
+	// ParseToken verifies the token and returns its claims.
+	// It returns ErrUnexpectedTokenType if the token was not issued as expectedType.
+	ParseToken(tokenString string, expectedType TokenType) (*TokenClaims, error)
+	ValidateToken(tokenString string, expectedType TokenType) error
+	// GenerateChallengeToken issues a short-lived token to finish a login with a second factor
+	GenerateChallengeToken(userID uuid.UUID, role string, ttl time.Duration) (string, error)
+	// PublicKeys returns the keys that verify issued tokens, including keys kept after a rotation
+	PublicKeys() JSONWebKeySet
+}
+
+// JSONWebKey is the public part of a signing key in JWK format (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519 keys
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet is a set of public signing keys
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// Roles allowed to enable two-factor authentication
+var TwoFactorRoles = []shared.Role{shared.RoleTutor, shared.RoleAdmin}
+
+// Number of recovery codes generated when 2FA is confirmed
+const RecoveryCodeCount = 10
+
+// ThrottleScopeTwoFactor counts failed second factor codes per user
+const ThrottleScopeTwoFactor = "2fa"
+
+// TwoFactor holds the TOTP secret of a user. It is pending until confirmed with a first code.
+type TwoFactor struct {
+	UserID uuid.UUID `json:"user_id" gorm:"type:uuid;primary_key;"`
+	// Secret is encrypted with a SecretCipher
+	Secret      string     `json:"-" gorm:"not null"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+	// LastUsedStep is the TOTP time step of the last accepted code, so codes cannot be replayed
+	LastUsedStep int64     `json:"-" gorm:"not null;default:0"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName overrides the pluralized default
+func (TwoFactor) TableName() string {
+	return "user_two_factor"
+}
+
+// Enabled reports whether the second factor has been confirmed
+func (t *TwoFactor) Enabled() bool {
+	return t.ConfirmedAt != nil
+}
+
+// RecoveryCode is a hashed one-time code that replaces a TOTP code when the authenticator is lost
+type RecoveryCode struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	UserID    uuid.UUID  `json:"user_id" gorm:"type:uuid;not null"`
+	CodeHash  string     `json:"-" gorm:"not null;uniqueIndex"`
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName overrides the default table name
+func (RecoveryCode) TableName() string {
+	return "two_factor_recovery_codes"
+}
+
+// TwoFactorEnrollment is what an authenticator app needs to add the account
+type TwoFactorEnrollment struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+// VerifyTwoFactorRequest completes a login with a TOTP code or a recovery code
+type VerifyTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required"`
+
+	// Client the attempt comes from, used for brute-force protection
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// DisableTwoFactorRequest turns 2FA off, proven with the password and a current code
+type DisableTwoFactorRequest struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+// TOTPProvider generates and checks time-based one-time passwords
+type TOTPProvider interface {
+	// GenerateSecret creates a secret and its otpauth:// URI for an authenticator app
+	GenerateSecret(issuer, accountName string) (secret, uri string, err error)
+	// ValidateCode checks a code against the secret around t and returns the time step it belongs to
+	ValidateCode(secret, code string, t time.Time) (step int64, ok bool)
+}
+
+// SecretCipher encrypts secrets stored in the database
+type SecretCipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
+
+// UserIdentity links an account at an external OpenID Connect provider to a user
+type UserIdentity struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null"`
+	Provider  string    `json:"provider" gorm:"not null"`
+	Subject   string    `json:"subject" gorm:"not null"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// OIDCState is the server-side half of an authorization request, consumed by its callback
+type OIDCState struct {
+	// StateHash is the SHA-256 hash of the state parameter sent to the provider
+	StateHash    string      `gorm:"primaryKey"`
+	Provider     string      `gorm:"not null"`
+	Nonce        string      `gorm:"not null"`
+	CodeVerifier string      `gorm:"not null"`
+	Role         shared.Role `gorm:"not null"`
+	ExpiresAt    time.Time   `gorm:"not null"`
+	CreatedAt    time.Time   `gorm:"autoCreateTime"`
+}
+
+// OIDCStateTTL is how long a user has to finish signing in at the provider
+const OIDCStateTTL = 10 * time.Minute
+
+// OIDCAuthorizeRequest starts a login with an external provider
+type OIDCAuthorizeRequest struct {
+	Provider string `json:"-"`
+	// Role of the account created on first login, student unless set
+	Role shared.Role `json:"role"`
+}
+
+// OIDCAuthorization is where the client sends the user to sign in
+type OIDCAuthorization struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+// OIDCCallbackRequest finishes a login with the parameters the provider redirected back with
+type OIDCCallbackRequest struct {
+	Provider string `json:"-"`
+	Code     string `json:"code" validate:"required"`
+	State    string `json:"state" validate:"required"`
+
+	// Client the login comes from, recorded on the session
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+// ExternalIdentity is the verified identity an OpenID Connect provider returned
+type ExternalIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	FirstName     string
+	LastName      string
+}
+
+// OIDCProvider is an OpenID Connect provider users can sign in with
+type OIDCProvider interface {
+	// AuthCodeURL returns the authorization URL for the state, nonce and PKCE code verifier
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems the authorization code and verifies the ID token and its nonce
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*ExternalIdentity, error)
 }
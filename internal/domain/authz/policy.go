@@ -0,0 +1,63 @@
+// Package authz holds the authorization policies shared by the domain services.
+// Services load the acting user and the resources involved, then ask the policy
+// whether the action is allowed.
+package authz
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// Relations answers questions about how users relate to each other
+type Relations interface {
+	// IsTutorOfStudent reports whether the student is enrolled in a course taught by the tutor
+	IsTutorOfStudent(tutorID, studentID uuid.UUID) (bool, error)
+}
+
+// Policy decides whether a user may act on another user's data
+type Policy struct {
+	relations Relations
+}
+
+// NewPolicy creates a policy that looks up user relations through relations
+func NewPolicy(relations Relations) Policy {
+	return Policy{relations: relations}
+}
+
+// CanManageUser allows users to manage their own account and admins to manage any account
+func (p Policy) CanManageUser(actor *shared.User, targetID uuid.UUID) error {
+	if actor == nil {
+		return shared.ErrUnauthorized
+	}
+	if actor.ID == targetID || actor.Role.Can(shared.PermissionManageUsers) {
+		return nil
+	}
+	return shared.ErrForbidden
+}
+
+// CanChangeStudentStatus allows users managing all users to change the status of anyone
+// and tutors to change the status of students enrolled in one of their courses
+func (p Policy) CanChangeStudentStatus(actor, student *shared.User) error {
+	if actor == nil {
+		return shared.ErrUnauthorized
+	}
+	if student == nil {
+		return shared.ErrNotFound
+	}
+
+	if actor.Role.Can(shared.PermissionManageUsers) {
+		return nil
+	}
+	if actor.Role != shared.RoleTutor || student.Role != shared.RoleStudent {
+		return shared.ErrForbidden
+	}
+
+	teaches, err := p.relations.IsTutorOfStudent(actor.ID, student.ID)
+	if err != nil {
+		return shared.ErrDatabaseError
+	}
+	if !teaches {
+		return shared.ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package authz
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// fakeRelations is a Relations backed by a set of tutor/student pairs
+type fakeRelations struct {
+	pairs map[[2]uuid.UUID]bool
+	err   error
+}
+
+func (f fakeRelations) IsTutorOfStudent(tutorID, studentID uuid.UUID) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	return f.pairs[[2]uuid.UUID{tutorID, studentID}], nil
+}
+
+func newUser(role shared.Role) *shared.User {
+	return &shared.User{ID: uuid.New(), Role: role}
+}
+
+func TestCanManageUser(t *testing.T) {
+	student := newUser(shared.RoleStudent)
+	otherStudent := newUser(shared.RoleStudent)
+	tutor := newUser(shared.RoleTutor)
+	admin := newUser(shared.RoleAdmin)
+
+	tests := []struct {
+		name    string
+		actor   *shared.User
+		target  uuid.UUID
+		wantErr error
+	}{
+		{name: "student manages own account", actor: student, target: student.ID},
+		{name: "tutor manages own account", actor: tutor, target: tutor.ID},
+		{name: "admin manages own account", actor: admin, target: admin.ID},
+		{name: "admin manages another account", actor: admin, target: student.ID},
+		{name: "student manages another student", actor: student, target: otherStudent.ID, wantErr: shared.ErrForbidden},
+		{name: "student manages a tutor", actor: student, target: tutor.ID, wantErr: shared.ErrForbidden},
+		{name: "tutor manages a student", actor: tutor, target: student.ID, wantErr: shared.ErrForbidden},
+		{name: "tutor manages an admin", actor: tutor, target: admin.ID, wantErr: shared.ErrForbidden},
+		{name: "missing actor", actor: nil, target: student.ID, wantErr: shared.ErrUnauthorized},
+	}
+
+	policy := NewPolicy(fakeRelations{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.CanManageUser(tt.actor, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CanManageUser() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanChangeStudentStatus(t *testing.T) {
+	student := newUser(shared.RoleStudent)
+	otherStudent := newUser(shared.RoleStudent)
+	tutor := newUser(shared.RoleTutor)
+	otherTutor := newUser(shared.RoleTutor)
+	admin := newUser(shared.RoleAdmin)
+
+	relations := fakeRelations{pairs: map[[2]uuid.UUID]bool{
+		{tutor.ID, student.ID}: true,
+	}}
+	failingRelations := fakeRelations{err: errors.New("connection refused")}
+
+	tests := []struct {
+		name      string
+		relations Relations
+		actor     *shared.User
+		student   *shared.User
+		wantErr   error
+	}{
+		{name: "admin changes a student", relations: relations, actor: admin, student: student},
+		{name: "admin changes a tutor", relations: relations, actor: admin, student: otherTutor},
+		{name: "tutor changes own student", relations: relations, actor: tutor, student: student},
+		{name: "tutor changes a student of another tutor", relations: relations, actor: tutor, student: otherStudent, wantErr: shared.ErrForbidden},
+		{name: "other tutor changes the student", relations: relations, actor: otherTutor, student: student, wantErr: shared.ErrForbidden},
+		{name: "tutor changes another tutor", relations: relations, actor: tutor, student: otherTutor, wantErr: shared.ErrForbidden},
+		{name: "tutor changes an admin", relations: relations, actor: tutor, student: admin, wantErr: shared.ErrForbidden},
+		{name: "student changes itself", relations: relations, actor: student, student: student, wantErr: shared.ErrForbidden},
+		{name: "student changes another student", relations: relations, actor: student, student: otherStudent, wantErr: shared.ErrForbidden},
+		{name: "missing actor", relations: relations, actor: nil, student: student, wantErr: shared.ErrUnauthorized},
+		{name: "missing student", relations: relations, actor: admin, student: nil, wantErr: shared.ErrNotFound},
+		{name: "relation lookup fails", relations: failingRelations, actor: tutor, student: student, wantErr: shared.ErrDatabaseError},
+		{name: "admin skips relation lookup", relations: failingRelations, actor: admin, student: student},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewPolicy(tt.relations).CanChangeStudentStatus(tt.actor, tt.student)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CanChangeStudentStatus() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
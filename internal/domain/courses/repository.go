@@ -3,6 +3,11 @@ package courses
 import "github.com/google/uuid"
 
 type Repository interface {
-	GetCourses() ([]Course, error)
+	GetCourses(filter CourseFilter) ([]Course, int64, error)
 	GetCourseByID(id uuid.UUID) (*Course, error)
+	CreateCourse(course *Course) error
+	UpdateCourse(course *Course) error
+	DeleteCourse(id uuid.UUID) error
+	// CategoryExists reports whether a course category exists
+	CategoryExists(categoryID uuid.UUID) (bool, error)
 }
@@ -1,24 +1,263 @@
 package courses
 
-import "github.com/google/uuid"
+import (
+	"errors"
+	"strings"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 type Service interface {
-	GetCourses() ([]Course, error)
+	GetCourses(filter CourseFilter) (*GetCoursesResponse, error)
 	GetCourseByID(id uuid.UUID) (*Course, error)
+	CreateCourse(actorID uuid.UUID, req *CreateCourseRequest) (*Course, error)
+	UpdateCourse(actorID, courseID uuid.UUID, req *UpdateCourseRequest) (*Course, error)
+	ArchiveCourse(actorID, courseID uuid.UUID) (*Course, error)
+	DeleteCourse(actorID, courseID uuid.UUID) error
 }
 
 type service struct {
-	courseRepo Repository
+	courseRepo         Repository
+	userRepo           user.Repository
+	verificationPolicy shared.VerificationPolicy
 }
 
-func NewService(courseRepo Repository) Service {
-	return &service{courseRepo: courseRepo}
+func NewService(courseRepo Repository, userRepo user.Repository, verificationPolicy shared.VerificationPolicy) Service {
+	return &service{
+		courseRepo:         courseRepo,
+		userRepo:           userRepo,
+		verificationPolicy: verificationPolicy,
+	}
 }
 
-func (s *service) GetCourses() ([]Course, error) {
-	return s.courseRepo.GetCourses()
+// GetCourses returns a page of published courses matching the filter
+func (s *service) GetCourses(filter CourseFilter) (*GetCoursesResponse, error) {
+	if filter.Page == 0 {
+		filter.Page = 1
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultPageLimit
+	}
+	if filter.Page < 1 || filter.Limit < 1 || filter.Limit > MaxPageLimit {
+		return nil, shared.ErrInvalidInput
+	}
+	if filter.MinRating != nil && *filter.MinRating < 0 {
+		return nil, shared.ErrInvalidInput
+	}
+
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = SortByCreatedAt
+	case SortByRating, SortByStudentsCount, SortByCreatedAt:
+	default:
+		return nil, shared.NewAPIError(400, "sort must be one of rating, students_count, created_at")
+	}
+	switch filter.SortOrder {
+	case "":
+		filter.SortOrder = SortOrderDesc
+	case SortOrderAsc, SortOrderDesc:
+	default:
+		return nil, shared.NewAPIError(400, "order must be asc or desc")
+	}
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	courses, total, err := s.courseRepo.GetCourses(filter)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return &GetCoursesResponse{
+		Pagination: Pagination{
+			Page:  filter.Page,
+			Limit: filter.Limit,
+			Total: int(total),
+		},
+		Courses: courses,
+		Total:   int(total),
+	}, nil
 }
 
 func (s *service) GetCourseByID(id uuid.UUID) (*Course, error) {
-	return s.courseRepo.GetCourseByID(id)
+	course, err := s.courseRepo.GetCourseByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	return course, nil
+}
+
+// CreateCourse creates a course owned by a tutor.
+// Tutors always own the courses they create, admins must name the owning tutor.
+func (s *service) CreateCourse(actorID uuid.UUID, req *CreateCourseRequest) (*Course, error) {
+	if req == nil {
+		return nil, shared.ErrMissingFields
+	}
+	if strings.TrimSpace(req.Title) == "" || strings.TrimSpace(req.Description) == "" ||
+		req.Duration == "" || req.CategoryID == uuid.Nil {
+		return nil, shared.ErrMissingFields
+	}
+
+	actor, err := s.userRepo.GetByID(actorID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+	if err := s.verificationPolicy.Check(actor, shared.FeatureCourseAuthoring); err != nil {
+		return nil, err
+	}
+
+	var tutorID uuid.UUID
+	switch {
+	case actor.Role.Can(shared.PermissionManageAllCourses):
+		if req.TutorID == nil {
+			return nil, shared.NewAPIError(400, "tutor_id is required when an admin creates a course")
+		}
+		tutor, err := s.userRepo.GetByID(*req.TutorID)
+		if err != nil || tutor.Role != shared.RoleTutor {
+			return nil, shared.NewAPIError(400, "tutor_id must reference an existing tutor")
+		}
+		tutorID = tutor.ID
+	case actor.Role.Can(shared.PermissionAuthorCourses):
+		if req.TutorID != nil && *req.TutorID != actor.ID {
+			return nil, shared.ErrForbidden
+		}
+		tutorID = actor.ID
+	default:
+		return nil, shared.ErrForbidden
+	}
+
+	if err := s.checkCategory(req.CategoryID); err != nil {
+		return nil, err
+	}
+
+	course := &Course{
+		ID:          uuid.New(),
+		TutorID:     tutorID,
+		Title:       req.Title,
+		Description: req.Description,
+		Duration:    req.Duration,
+		CategoryID:  req.CategoryID,
+	}
+	if err := s.courseRepo.CreateCourse(course); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return s.GetCourseByID(course.ID)
+}
+
+// UpdateCourse applies a partial update to a course owned by the actor
+func (s *service) UpdateCourse(actorID, courseID uuid.UUID, req *UpdateCourseRequest) (*Course, error) {
+	if req == nil {
+		return nil, shared.ErrMissingFields
+	}
+
+	course, err := s.getOwnedCourse(actorID, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		if strings.TrimSpace(*req.Title) == "" {
+			return nil, shared.ErrInvalidInput
+		}
+		course.Title = *req.Title
+	}
+	if req.Description != nil {
+		if strings.TrimSpace(*req.Description) == "" {
+			return nil, shared.ErrInvalidInput
+		}
+		course.Description = *req.Description
+	}
+	if req.Duration != nil {
+		course.Duration = *req.Duration
+	}
+	if req.CategoryID != nil {
+		if *req.CategoryID == uuid.Nil {
+			return nil, shared.ErrInvalidInput
+		}
+		if err := s.checkCategory(*req.CategoryID); err != nil {
+			return nil, err
+		}
+		course.CategoryID = *req.CategoryID
+	}
+
+	if err := s.courseRepo.UpdateCourse(course); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return s.GetCourseByID(course.ID)
+}
+
+// ArchiveCourse hides a course from the catalog without deleting its data
+func (s *service) ArchiveCourse(actorID, courseID uuid.UUID) (*Course, error) {
+	course, err := s.getOwnedCourse(actorID, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	course.IsArchived = true
+	if err := s.courseRepo.UpdateCourse(course); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return s.GetCourseByID(course.ID)
+}
+
+// DeleteCourse permanently deletes a course owned by the actor
+func (s *service) DeleteCourse(actorID, courseID uuid.UUID) error {
+	course, err := s.getOwnedCourse(actorID, courseID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.courseRepo.DeleteCourse(course.ID); err != nil {
+		return shared.ErrDatabaseError
+	}
+
+	return nil
+}
+
+// getOwnedCourse loads a course and checks that the actor is an admin or its tutor
+func (s *service) getOwnedCourse(actorID, courseID uuid.UUID) (*Course, error) {
+	if actorID == uuid.Nil || courseID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	actor, err := s.userRepo.GetByID(actorID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+	if !actor.Role.Can(shared.PermissionAuthorCourses) {
+		return nil, shared.ErrForbidden
+	}
+	if err := s.verificationPolicy.Check(actor, shared.FeatureCourseAuthoring); err != nil {
+		return nil, err
+	}
+
+	course, err := s.GetCourseByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !actor.Role.Can(shared.PermissionManageAllCourses) && course.TutorID != actor.ID {
+		return nil, shared.ErrForbidden
+	}
+
+	return course, nil
+}
+
+// checkCategory rejects categories that do not exist
+func (s *service) checkCategory(categoryID uuid.UUID) error {
+	exists, err := s.courseRepo.CategoryExists(categoryID)
+	if err != nil {
+		return shared.ErrDatabaseError
+	}
+	if !exists {
+		return shared.NewAPIError(400, "Category not found")
+	}
+	return nil
 }
@@ -13,23 +13,20 @@ type Pagination struct {
 }
 
 type Course struct {
-	ID               uuid.UUID `json:"id" gorm:"type:uuid;primary_key;"`
-	StudentID        uuid.UUID `json:"student_id" gorm:"type:uuid;not null"`
-	TutorID          uuid.UUID `json:"tutor_id" gorm:"type:uuid;not null"`
-	Title            string    `json:"title" gorm:"not null"`
-	Description      string    `json:"description" gorm:"not null"`
-	Progress         int       `json:"progress" gorm:"not null"`
-	TotalLessons     int       `json:"total_lessons" gorm:"not null"`
-	CompletedLessons int       `json:"completed_lessons" gorm:"not null"`
-	Duration         string    `json:"duration" gorm:"not null"`
-	StudentsCount    int       `json:"students_count" gorm:"not null"`
-	Rating           float32   `json:"rating" gorm:"not null"`
-	CategoryID       uuid.UUID `json:"category_id" gorm:"type:uuid;not null"`
-	CreatedAt        time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt        time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	ID            uuid.UUID `json:"id" gorm:"type:uuid;primary_key;"`
+	TutorID       uuid.UUID `json:"tutor_id" gorm:"type:uuid;not null"`
+	Title         string    `json:"title" gorm:"not null"`
+	Description   string    `json:"description" gorm:"not null"`
+	TotalLessons  int       `json:"total_lessons" gorm:"not null"`
+	Duration      string    `json:"duration" gorm:"not null"`
+	StudentsCount int       `json:"students_count" gorm:"not null"`
+	Rating        float32   `json:"rating" gorm:"not null"`
+	CategoryID    uuid.UUID `json:"category_id" gorm:"type:uuid;not null"`
+	IsArchived    bool      `json:"is_archived" gorm:"column:is_archived;default:false"`
+	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 
 	// Related data
-	Student  *User     `json:"student,omitempty" gorm:"foreignKey:StudentID;references:ID"`
 	Tutor    *User     `json:"tutor,omitempty" gorm:"foreignKey:TutorID;references:ID"`
 	Category *Category `json:"category,omitempty" gorm:"foreignKey:CategoryID;references:ID"`
 }
@@ -54,3 +51,48 @@ type GetCoursesResponse struct {
 	Duration   string     `json:"duration"`
 	Total      int        `json:"total"`
 }
+
+// Sort fields and directions supported when listing courses
+const (
+	SortByRating        = "rating"
+	SortByStudentsCount = "students_count"
+	SortByCreatedAt     = "created_at"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// Page size limits for course listings
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// CourseFilter describes which page of courses to return and how to filter and sort them
+type CourseFilter struct {
+	Page       int
+	Limit      int
+	CategoryID *uuid.UUID
+	TutorID    *uuid.UUID
+	MinRating  *float32
+	Query      string
+	SortBy     string
+	SortOrder  string
+}
+
+// CreateCourseRequest represents the request to create a course
+type CreateCourseRequest struct {
+	Title       string     `json:"title" validate:"required"`
+	Description string     `json:"description" validate:"required"`
+	Duration    string     `json:"duration" validate:"required"`
+	CategoryID  uuid.UUID  `json:"category_id" validate:"required"`
+	TutorID     *uuid.UUID `json:"tutor_id,omitempty"`
+}
+
+// UpdateCourseRequest represents a partial update of a course
+type UpdateCourseRequest struct {
+	Title       *string    `json:"title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Duration    *string    `json:"duration,omitempty"`
+	CategoryID  *uuid.UUID `json:"category_id,omitempty"`
+}
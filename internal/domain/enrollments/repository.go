@@ -0,0 +1,23 @@
+package enrollments
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrEnrollmentExists is returned when the student is already enrolled in the course
+var ErrEnrollmentExists = errors.New("student is already enrolled in the course")
+
+type Repository interface {
+	GetEnrollment(courseID, studentID uuid.UUID) (*Enrollment, error)
+	GetStudentEnrollments(studentID uuid.UUID) ([]Enrollment, error)
+	// CreateEnrollment stores the enrollment, points it at the first published lesson and
+	// increments the course students count atomically.
+	// Returns ErrEnrollmentExists if the student is already enrolled in the course.
+	CreateEnrollment(enrollment *Enrollment) error
+	// DeleteEnrollment removes the enrollment and decrements the course students count atomically
+	DeleteEnrollment(enrollment *Enrollment) error
+	// IsTutorOfStudent reports whether the student is enrolled in a course taught by the tutor
+	IsTutorOfStudent(tutorID, studentID uuid.UUID) (bool, error)
+}
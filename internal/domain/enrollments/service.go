@@ -0,0 +1,128 @@
+package enrollments
+
+import (
+	"errors"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Service interface {
+	Enroll(studentID, courseID uuid.UUID) (*Enrollment, error)
+	Unenroll(studentID, courseID uuid.UUID) error
+	GetEnrollment(studentID, courseID uuid.UUID) (*Enrollment, error)
+	GetStudentEnrollments(studentID uuid.UUID) ([]Enrollment, error)
+}
+
+type service struct {
+	enrollmentRepo     Repository
+	courseRepo         courses.Repository
+	userRepo           user.Repository
+	verificationPolicy shared.VerificationPolicy
+}
+
+func NewService(enrollmentRepo Repository, courseRepo courses.Repository, userRepo user.Repository, verificationPolicy shared.VerificationPolicy) Service {
+	return &service{
+		enrollmentRepo:     enrollmentRepo,
+		courseRepo:         courseRepo,
+		userRepo:           userRepo,
+		verificationPolicy: verificationPolicy,
+	}
+}
+
+// Enroll enrolls a student in a published course
+func (s *service) Enroll(studentID, courseID uuid.UUID) (*Enrollment, error) {
+	if studentID == uuid.Nil || courseID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	student, err := s.userRepo.GetByID(studentID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+	if !student.Role.Can(shared.PermissionEnroll) {
+		return nil, shared.ErrForbidden
+	}
+	if err := s.verificationPolicy.Check(student, shared.FeatureEnroll); err != nil {
+		return nil, err
+	}
+
+	course, err := s.courseRepo.GetCourseByID(courseID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if course.IsArchived {
+		return nil, shared.ErrNotFound
+	}
+
+	if _, err := s.enrollmentRepo.GetEnrollment(courseID, studentID); err == nil {
+		return nil, shared.ErrAlreadyEnrolled
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, shared.ErrDatabaseError
+	}
+
+	enrollment := &Enrollment{
+		ID:        uuid.New(),
+		CourseID:  course.ID,
+		StudentID: student.ID,
+	}
+	if err := s.enrollmentRepo.CreateEnrollment(enrollment); err != nil {
+		if errors.Is(err, ErrEnrollmentExists) {
+			return nil, shared.ErrAlreadyEnrolled
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	return enrollment, nil
+}
+
+// Unenroll removes a student's enrollment together with its progress
+func (s *service) Unenroll(studentID, courseID uuid.UUID) error {
+	enrollment, err := s.GetEnrollment(studentID, courseID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.enrollmentRepo.DeleteEnrollment(enrollment); err != nil {
+		return shared.ErrDatabaseError
+	}
+
+	return nil
+}
+
+// GetEnrollment retrieves a student's enrollment in a course
+func (s *service) GetEnrollment(studentID, courseID uuid.UUID) (*Enrollment, error) {
+	if studentID == uuid.Nil || courseID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	enrollment, err := s.enrollmentRepo.GetEnrollment(courseID, studentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	return enrollment, nil
+}
+
+// GetStudentEnrollments retrieves all enrollments of a student
+func (s *service) GetStudentEnrollments(studentID uuid.UUID) ([]Enrollment, error) {
+	if studentID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	enrollments, err := s.enrollmentRepo.GetStudentEnrollments(studentID)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return enrollments, nil
+}
@@ -0,0 +1,19 @@
+package enrollments
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Enrollment represents a student's participation in a course and their progress in it
+type Enrollment struct {
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	CourseID         uuid.UUID  `json:"course_id" gorm:"type:uuid;not null"`
+	StudentID        uuid.UUID  `json:"student_id" gorm:"type:uuid;not null"`
+	Progress         int        `json:"progress" gorm:"not null"`
+	CompletedLessons int        `json:"completed_lessons" gorm:"not null"`
+	NextLessonID     *uuid.UUID `json:"next_lesson_id,omitempty" gorm:"type:uuid"`
+	CreatedAt        time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt        time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
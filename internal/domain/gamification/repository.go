@@ -0,0 +1,24 @@
+package gamification
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for XP data operations
+type Repository interface {
+	// GetStatsForUpdate loads the stats of a user and locks them until the transaction ends
+	GetStatsForUpdate(userID uuid.UUID) (*user.UserStats, error)
+	// AddEntry records a ledger entry, returns false if the event of the entry was already rewarded
+	AddEntry(entry *LedgerEntry) (bool, error)
+	// SumXP returns the total of the ledger entries of a user
+	SumXP(userID uuid.UUID) (int, error)
+	// UpdateProgress sets the XP, level and next level threshold of a user
+	UpdateProgress(userID uuid.UUID, xp, level, nextLevelXP int) error
+	// GetLedger lists the entries of a user newest first
+	GetLedger(userID uuid.UUID, limit, offset int) ([]LedgerEntry, int64, error)
+
+	// WithTx returns the repository bound to a transaction of a shared.UnitOfWork
+	WithTx(tx shared.Tx) Repository
+}
@@ -0,0 +1,179 @@
+package gamification
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/authz"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Service defines the interface for XP and level business logic
+type Service interface {
+	// Subscribe registers the service for the events its rules reward
+	Subscribe(bus shared.EventBus)
+	GetLedger(actorID, userID uuid.UUID, page, limit int) (*LedgerPage, error)
+	// Recompute rebuilds the XP and level of a user from the ledger, for audits and after curve changes
+	Recompute(actorID, userID uuid.UUID) (*user.UserStats, error)
+}
+
+// service implements the XP and level business logic
+type service struct {
+	config   Config
+	uow      shared.UnitOfWork
+	repo     Repository
+	userRepo user.Repository
+	policy   authz.Policy
+}
+
+// NewService creates a new gamification service
+func NewService(config Config, uow shared.UnitOfWork, repo Repository, userRepo user.Repository, policy authz.Policy) Service {
+	return &service{
+		config:   config,
+		uow:      uow,
+		repo:     repo,
+		userRepo: userRepo,
+		policy:   policy,
+	}
+}
+
+// Subscribe registers the service for every event type a rule awards XP for
+func (s *service) Subscribe(bus shared.EventBus) {
+	for eventType, points := range s.config.Rules {
+		if points > 0 {
+			bus.Subscribe(eventType, s.handleEvent)
+		}
+	}
+}
+
+// handleEvent records the ledger entry of a published event and updates the progress of the user.
+// Events that were already rewarded leave the progress unchanged.
+func (s *service) handleEvent(event shared.Event) error {
+	points := s.config.Rules[event.Type]
+	if points <= 0 {
+		return nil
+	}
+
+	entry := &LedgerEntry{
+		ID:        uuid.New(),
+		UserID:    event.UserID,
+		EventType: event.Type,
+		SubjectID: event.SubjectID,
+		Points:    points,
+	}
+	err := s.uow.Do(func(tx shared.Tx) error {
+		repo := s.repo.WithTx(tx)
+
+		stats, err := repo.GetStatsForUpdate(event.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to load stats: %w", err)
+		}
+
+		added, err := repo.AddEntry(entry)
+		if err != nil {
+			return fmt.Errorf("failed to record XP: %w", err)
+		}
+		if !added {
+			return nil
+		}
+		return s.applyProgress(repo, stats)
+	})
+	if err != nil {
+		return fmt.Errorf("event %s of user %s: %w", event.Type, event.UserID, err)
+	}
+	return nil
+}
+
+// GetLedger returns a page of the XP history of a user to the user and admins
+func (s *service) GetLedger(actorID, userID uuid.UUID, page, limit int) (*LedgerPage, error) {
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.policy.CanManageUser(actor, userID); err != nil {
+		return nil, err
+	}
+
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultLedgerLimit
+	}
+	if page < 1 || limit < 1 || limit > maxLedgerLimit {
+		return nil, shared.ErrInvalidInput
+	}
+
+	entries, total, err := s.repo.GetLedger(userID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return &LedgerPage{
+		Entries: entries,
+		Page:    page,
+		Limit:   limit,
+		Total:   int(total),
+	}, nil
+}
+
+// Recompute rebuilds the XP and level of a user from the ledger
+func (s *service) Recompute(actorID, userID uuid.UUID) (*user.UserStats, error) {
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Role.Can(shared.PermissionManageUsers) {
+		return nil, shared.ErrForbidden
+	}
+
+	var stats *user.UserStats
+	err = s.uow.Do(func(tx shared.Tx) error {
+		repo := s.repo.WithTx(tx)
+
+		stats, err = repo.GetStatsForUpdate(userID)
+		if err != nil {
+			return err
+		}
+		return s.applyProgress(repo, stats)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	return stats, nil
+}
+
+// applyProgress sets the XP of the stats to the ledger total and derives the level from the curve
+func (s *service) applyProgress(repo Repository, stats *user.UserStats) error {
+	xp, err := repo.SumXP(stats.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to sum XP: %w", err)
+	}
+
+	stats.XP = xp
+	stats.Level, stats.NextLevelXP = s.config.Curve.Level(xp)
+	if err := repo.UpdateProgress(stats.UserID, stats.XP, stats.Level, stats.NextLevelXP); err != nil {
+		return fmt.Errorf("failed to update progress: %w", err)
+	}
+	return nil
+}
+
+// getActor loads the authenticated user a request is made by
+func (s *service) getActor(actorID uuid.UUID) (*shared.User, error) {
+	if actorID == uuid.Nil {
+		return nil, shared.ErrUnauthorized
+	}
+	actor, err := s.userRepo.GetByID(actorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrUnauthorized
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	return actor, nil
+}
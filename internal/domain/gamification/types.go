@@ -0,0 +1,82 @@
+package gamification
+
+import (
+	"math"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// LedgerEntry is XP awarded to a user for an event. An event is rewarded at most once,
+// and the XP of UserStats is always the sum of the entries of the user.
+type LedgerEntry struct {
+	ID        uuid.UUID        `json:"id" gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID        `json:"user_id" gorm:"type:uuid;not null"`
+	EventType shared.EventType `json:"event_type" gorm:"type:varchar(64);not null"`
+	SubjectID uuid.UUID        `json:"subject_id" gorm:"type:uuid;not null"`
+	Points    int              `json:"points" gorm:"not null"`
+	CreatedAt time.Time        `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName keeps the ledger table name singular
+func (LedgerEntry) TableName() string {
+	return "xp_ledger"
+}
+
+// Rules are the XP awarded per event type, events without a rule award nothing
+type Rules map[shared.EventType]int
+
+// DefaultRules returns the XP awarded when no rules are configured
+func DefaultRules() Rules {
+	return Rules{
+		shared.EventLessonCompleted: 10,
+		shared.EventCourseCompleted: 100,
+	}
+}
+
+// Curve defines the XP needed per level. Reaching level 2 takes BaseXP,
+// every further level takes Growth times the XP of the previous one.
+type Curve struct {
+	BaseXP int
+	Growth float64
+}
+
+// DefaultCurve returns the level curve new accounts are created with
+func DefaultCurve() Curve {
+	return Curve{BaseXP: user.InitialNextLevelXP, Growth: 1.5}
+}
+
+// Level returns the level reached with totalXP and the total XP the next level is reached at
+func (c Curve) Level(totalXP int) (level, nextLevelXP int) {
+	level = user.InitialLevel
+	step := float64(c.BaseXP)
+	nextLevelXP = c.BaseXP
+	for totalXP >= nextLevelXP {
+		level++
+		step = math.Max(step*c.Growth, 1)
+		nextLevelXP += int(math.Round(step))
+	}
+	return level, nextLevelXP
+}
+
+// Config holds the XP rules and level curve
+type Config struct {
+	Rules Rules
+	Curve Curve
+}
+
+// Page sizes of the XP ledger
+const (
+	defaultLedgerLimit = 20
+	maxLedgerLimit     = 100
+)
+
+// LedgerPage is a page of ledger entries, newest first
+type LedgerPage struct {
+	Entries []LedgerEntry `json:"entries"`
+	Page    int           `json:"page"`
+	Limit   int           `json:"limit"`
+	Total   int           `json:"total"`
+}
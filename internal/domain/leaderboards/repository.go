@@ -0,0 +1,15 @@
+package leaderboards
+
+import (
+	"github.com/google/uuid"
+)
+
+// Repository defines the interface for leaderboard data operations.
+// Only users who earned XP in the filter are ranked.
+type Repository interface {
+	// GetRanking ranks the users once and returns a page of the leaderboard by rank,
+	// the entry of the viewer and the number of ranked users
+	GetRanking(filter Filter, viewerID uuid.UUID, limit, offset int) (*Ranking, error)
+	// CategoryExists reports whether a course category exists
+	CategoryExists(categoryID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,76 @@
+package leaderboards
+
+import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// Service defines the interface for leaderboard business logic
+type Service interface {
+	GetLeaderboard(viewerID uuid.UUID, period Period, categoryID *uuid.UUID, page, limit int) (*Leaderboard, error)
+}
+
+// service implements the leaderboard business logic
+type service struct {
+	repo Repository
+}
+
+// NewService creates a new leaderboard service
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+// GetLeaderboard ranks users by the XP they earned in the period, optionally in one category,
+// and includes the rank of the viewer
+func (s *service) GetLeaderboard(viewerID uuid.UUID, period Period, categoryID *uuid.UUID, page, limit int) (*Leaderboard, error) {
+	if viewerID == uuid.Nil {
+		return nil, shared.ErrUnauthorized
+	}
+
+	if period == "" {
+		period = PeriodAllTime
+	}
+	if !period.Valid() {
+		return nil, shared.NewAPIError(400, "Invalid period. Must be one of: all_time, week, month")
+	}
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if page < 1 || limit < 1 || limit > maxLeaderboardLimit {
+		return nil, shared.ErrInvalidInput
+	}
+
+	if categoryID != nil {
+		exists, err := s.repo.CategoryExists(*categoryID)
+		if err != nil {
+			return nil, shared.ErrDatabaseError
+		}
+		if !exists {
+			return nil, shared.NewAPIError(404, "Category not found")
+		}
+	}
+
+	filter := Filter{
+		Since:      period.Since(time.Now()),
+		CategoryID: categoryID,
+	}
+	ranking, err := s.repo.GetRanking(filter, viewerID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return &Leaderboard{
+		Period:     period,
+		CategoryID: categoryID,
+		Entries:    ranking.Entries,
+		Me:         ranking.Me,
+		Page:       page,
+		Limit:      limit,
+		Total:      int(ranking.Total),
+	}, nil
+}
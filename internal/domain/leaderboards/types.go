@@ -0,0 +1,90 @@
+package leaderboards
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Period is the time window XP is counted in
+type Period string
+
+// Leaderboard periods
+const (
+	PeriodAllTime Period = "all_time"
+	// PeriodWeek counts XP earned since Monday 00:00 UTC
+	PeriodWeek Period = "week"
+	// PeriodMonth counts XP earned since the first day of the month, 00:00 UTC
+	PeriodMonth Period = "month"
+)
+
+// Periods lists every period
+var Periods = []Period{PeriodAllTime, PeriodWeek, PeriodMonth}
+
+// Valid reports whether the period is one of the known periods
+func (p Period) Valid() bool {
+	return slices.Contains(Periods, p)
+}
+
+// Since returns when the period started at now, or nil for all time
+func (p Period) Since(now time.Time) *time.Time {
+	now = now.UTC()
+	var since time.Time
+	switch p {
+	case PeriodWeek:
+		daysSinceMonday := (int(now.Weekday()) + 6) % 7
+		since = time.Date(now.Year(), now.Month(), now.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case PeriodMonth:
+		since = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return nil
+	}
+	return &since
+}
+
+// Filter selects the XP a leaderboard ranks users by
+type Filter struct {
+	// Since only counts XP earned from this time on, all XP when nil
+	Since *time.Time
+	// CategoryID only counts XP of lessons and courses of the category, all XP when nil
+	CategoryID *uuid.UUID
+}
+
+// Entry is the position of a user on a leaderboard. Users with the same XP share a rank.
+type Entry struct {
+	Rank      int       `json:"rank"`
+	UserID    uuid.UUID `json:"user_id"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Avatar    string    `json:"avatar"`
+	XP        int       `json:"xp"`
+	Level     int       `json:"level"`
+}
+
+// Ranking is a page of ranked entries with the entry of the viewer
+type Ranking struct {
+	Entries []Entry
+	// Me is the entry of the viewer, nil if the viewer is not ranked
+	Me *Entry
+	// Total is the number of ranked users
+	Total int64
+}
+
+// Page sizes of leaderboards
+const (
+	defaultLeaderboardLimit = 20
+	maxLeaderboardLimit     = 100
+)
+
+// Leaderboard is a page of a leaderboard with the position of the viewer
+type Leaderboard struct {
+	Period     Period     `json:"period"`
+	CategoryID *uuid.UUID `json:"category_id,omitempty"`
+	Entries    []Entry    `json:"entries"`
+	// Me is the entry of the viewer, nil if the viewer earned no XP in the filter
+	Me    *Entry `json:"me,omitempty"`
+	Page  int    `json:"page"`
+	Limit int    `json:"limit"`
+	Total int    `json:"total"`
+}
@@ -1,6 +1,34 @@
 package lessons
 
+import (
+	"errors"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
+	"github.com/google/uuid"
+)
+
+// ErrLessonOrderMismatch is returned when a new lesson order does not list every lesson of the course exactly once
+var ErrLessonOrderMismatch = errors.New("lesson order does not match the lessons of the course")
+
 type Repository interface {
-	GetLessons() ([]Lesson, error)
+	GetLessons(visibility LessonVisibility) ([]Lesson, error)
+	GetLessonByID(id uuid.UUID) (*Lesson, error)
+	// GetCourseLessons returns the lessons of a course ordered by position
+	GetCourseLessons(courseID uuid.UUID, publishedOnly bool) ([]Lesson, error)
+	// CreateLesson appends the lesson to the end of its course and updates the course lesson count
 	CreateLesson(lesson *Lesson) error
+	UpdateLesson(lesson *Lesson) error
+	// UpdateLessonStatus changes the lesson status and recalculates the course lesson count
+	// and the progress of every enrollment in the course
+	UpdateLessonStatus(lesson *Lesson) error
+	// DeleteLesson removes the lesson, closes the gap in positions and recalculates
+	// the course lesson count and enrollment progress
+	DeleteLesson(lesson *Lesson) error
+	// ReorderLessons assigns positions following lessonIDs in one transaction.
+	// lessonIDs must contain every lesson of the course exactly once, otherwise ErrLessonOrderMismatch is returned.
+	ReorderLessons(courseID uuid.UUID, lessonIDs []uuid.UUID) error
+	// CompleteLesson records the completion and recalculates the enrollment progress in one transaction.
+	// Progress only counts published lessons.
+	// Completing an already completed lesson keeps the original completion time.
+	CompleteLesson(completion *LessonCompletion) (*enrollments.Enrollment, error)
 }
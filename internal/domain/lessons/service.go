@@ -1,41 +1,389 @@
 package lessons
 
 import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Service interface {
-	GetLessons() ([]Lesson, error)
-	CreateLesson(creater_id uuid.UUID, lesson *Lesson) error
+	GetLessons(viewerID uuid.UUID) ([]Lesson, error)
+	GetCourseLessons(viewerID, courseID uuid.UUID) ([]Lesson, error)
+	ReorderLessons(actorID, courseID uuid.UUID, lessonIDs []uuid.UUID) ([]Lesson, error)
+	CreateLesson(actorID uuid.UUID, lesson *Lesson) error
+	UpdateLesson(actorID, lessonID uuid.UUID, req *UpdateLessonRequest) (*Lesson, error)
+	PublishLesson(actorID, lessonID uuid.UUID) (*Lesson, error)
+	ArchiveLesson(actorID, lessonID uuid.UUID) (*Lesson, error)
+	DeleteLesson(actorID, lessonID uuid.UUID) error
+	CompleteLesson(studentID, lessonID uuid.UUID) (*CompleteLessonResponse, error)
+}
+
+// allowedTransitions lists the statuses a lesson may move to from its current status
+var allowedTransitions = map[string][]string{
+	StatusDraft:     {StatusPublished},
+	StatusPublished: {StatusArchived},
+	StatusArchived:  {StatusPublished},
 }
 
 type service struct {
-	lessonsRepo Repository
-	userRepo    user.Repository
+	lessonsRepo        Repository
+	courseRepo         courses.Repository
+	userRepo           user.Repository
+	enrollmentRepo     enrollments.Repository
+	verificationPolicy shared.VerificationPolicy
+	events             shared.EventPublisher
 }
 
-func NewService(lessonsRepo Repository, userRepo user.Repository) Service {
+func NewService(lessonsRepo Repository, courseRepo courses.Repository, userRepo user.Repository, enrollmentRepo enrollments.Repository, verificationPolicy shared.VerificationPolicy, events shared.EventPublisher) Service {
 	return &service{
-		lessonsRepo: lessonsRepo,
-		userRepo:    userRepo,
+		lessonsRepo:        lessonsRepo,
+		courseRepo:         courseRepo,
+		userRepo:           userRepo,
+		enrollmentRepo:     enrollmentRepo,
+		verificationPolicy: verificationPolicy,
+		events:             events,
 	}
 }
 
-func (s *service) GetLessons() ([]Lesson, error) {
-	return s.lessonsRepo.GetLessons()
+// GetLessons returns the lessons visible to the viewer.
+// Admins see every lesson, tutors also see unpublished lessons of their own courses.
+func (s *service) GetLessons(viewerID uuid.UUID) ([]Lesson, error) {
+	viewer, err := s.userRepo.GetByID(viewerID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+
+	var visibility LessonVisibility
+	switch {
+	case viewer.Role.Can(shared.PermissionManageAllCourses):
+		visibility.AllStatuses = true
+	case viewer.Role.Can(shared.PermissionAuthorCourses):
+		visibility.OwnerID = &viewer.ID
+	}
+
+	lessons, err := s.lessonsRepo.GetLessons(visibility)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return lessons, nil
+}
+
+// GetCourseLessons returns the lessons of a course in author-defined order.
+// Unpublished lessons are only returned to the owning tutor and admins.
+func (s *service) GetCourseLessons(viewerID, courseID uuid.UUID) ([]Lesson, error) {
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer, err := s.userRepo.GetByID(viewerID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+
+	courseLessons, err := s.lessonsRepo.GetCourseLessons(course.ID, !canManageCourse(viewer, course))
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return courseLessons, nil
 }
 
-func (s *service) CreateLesson(creater_id uuid.UUID, lesson *Lesson) error {
-	// проверяем, что создатель - это админ
-	user, err := s.userRepo.GetByID(creater_id)
+// ReorderLessons sets a new lesson order for a course.
+// Only the tutor owning the course and admins may reorder its lessons.
+func (s *service) ReorderLessons(actorID, courseID uuid.UUID, lessonIDs []uuid.UUID) ([]Lesson, error) {
+	course, err := s.getCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := s.getAuthor(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageCourse(actor, course) {
+		return nil, shared.ErrForbidden
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(lessonIDs))
+	for _, id := range lessonIDs {
+		if _, ok := seen[id]; ok {
+			return nil, shared.NewAPIError(400, "lesson_ids must not contain duplicates")
+		}
+		seen[id] = struct{}{}
+	}
+
+	if err := s.lessonsRepo.ReorderLessons(course.ID, lessonIDs); err != nil {
+		if errors.Is(err, ErrLessonOrderMismatch) {
+			return nil, shared.NewAPIError(400, "lesson_ids must list every lesson of the course exactly once")
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	courseLessons, err := s.lessonsRepo.GetCourseLessons(course.ID, false)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return courseLessons, nil
+}
+
+// CreateLesson adds a draft lesson at the end of a course the actor manages
+func (s *service) CreateLesson(actorID uuid.UUID, lesson *Lesson) error {
+	if actorID == uuid.Nil || lesson == nil {
+		return shared.ErrInvalidInput
+	}
+	if strings.TrimSpace(lesson.Title) == "" || strings.TrimSpace(lesson.Description) == "" ||
+		strings.TrimSpace(lesson.VideoURL) == "" || lesson.Duration == "" {
+		return shared.ErrMissingFields
+	}
+
+	actor, err := s.getAuthor(actorID)
 	if err != nil {
 		return err
 	}
-	if user.Role != "admin" {
+
+	course, err := s.getCourse(lesson.CourseID)
+	if err != nil {
+		return err
+	}
+	if !canManageCourse(actor, course) {
 		return shared.ErrForbidden
 	}
 
-	return s.lessonsRepo.CreateLesson(lesson)
+	if lesson.Status == "" {
+		lesson.Status = StatusDraft
+	}
+
+	if err := s.lessonsRepo.CreateLesson(lesson); err != nil {
+		return shared.ErrDatabaseError
+	}
+
+	return nil
+}
+
+// UpdateLesson applies a partial update to a lesson of a course the actor manages
+func (s *service) UpdateLesson(actorID, lessonID uuid.UUID, req *UpdateLessonRequest) (*Lesson, error) {
+	if req == nil {
+		return nil, shared.ErrMissingFields
+	}
+
+	lesson, err := s.getManagedLesson(actorID, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		if strings.TrimSpace(*req.Title) == "" {
+			return nil, shared.ErrInvalidInput
+		}
+		lesson.Title = *req.Title
+	}
+	if req.Description != nil {
+		if strings.TrimSpace(*req.Description) == "" {
+			return nil, shared.ErrInvalidInput
+		}
+		lesson.Description = *req.Description
+	}
+	if req.VideoURL != nil {
+		if strings.TrimSpace(*req.VideoURL) == "" {
+			return nil, shared.ErrInvalidInput
+		}
+		lesson.VideoURL = *req.VideoURL
+	}
+	if req.Duration != nil {
+		if *req.Duration == "" {
+			return nil, shared.ErrInvalidInput
+		}
+		lesson.Duration = *req.Duration
+	}
+	lesson.UpdatedAt = time.Now()
+
+	if err := s.lessonsRepo.UpdateLesson(lesson); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return lesson, nil
+}
+
+// PublishLesson makes a draft or archived lesson visible to students
+func (s *service) PublishLesson(actorID, lessonID uuid.UUID) (*Lesson, error) {
+	return s.changeStatus(actorID, lessonID, StatusPublished)
+}
+
+// ArchiveLesson hides a published lesson from students without deleting it
+func (s *service) ArchiveLesson(actorID, lessonID uuid.UUID) (*Lesson, error) {
+	return s.changeStatus(actorID, lessonID, StatusArchived)
+}
+
+// DeleteLesson removes a lesson of a course the actor manages
+func (s *service) DeleteLesson(actorID, lessonID uuid.UUID) error {
+	lesson, err := s.getManagedLesson(actorID, lessonID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.lessonsRepo.DeleteLesson(lesson); err != nil {
+		return shared.ErrDatabaseError
+	}
+
+	return nil
+}
+
+// CompleteLesson marks a lesson as completed for the student and recalculates their course progress
+func (s *service) CompleteLesson(studentID, lessonID uuid.UUID) (*CompleteLessonResponse, error) {
+	if studentID == uuid.Nil || lessonID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	student, err := s.userRepo.GetByID(studentID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+	if err := s.verificationPolicy.Check(student, shared.FeatureLessonCompletion); err != nil {
+		return nil, err
+	}
+
+	lesson, err := s.lessonsRepo.GetLessonByID(lessonID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	if lesson.Status != StatusPublished {
+		return nil, shared.ErrNotFound
+	}
+
+	enrollment, err := s.enrollmentRepo.GetEnrollment(lesson.CourseID, studentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotEnrolled
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	completion := &LessonCompletion{
+		ID:           uuid.New(),
+		EnrollmentID: enrollment.ID,
+		LessonID:     lesson.ID,
+		CompletedAt:  time.Now(),
+	}
+	updatedEnrollment, err := s.lessonsRepo.CompleteLesson(completion)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	// Completing a lesson again publishes the events again, subscribers reward them only once
+	s.events.Publish(shared.NewEvent(shared.EventLessonCompleted, studentID, lesson.ID))
+	if updatedEnrollment.Progress == 100 {
+		s.events.Publish(shared.NewEvent(shared.EventCourseCompleted, studentID, lesson.CourseID))
+	}
+
+	return &CompleteLessonResponse{
+		LessonID:    completion.LessonID,
+		CompletedAt: completion.CompletedAt,
+		Enrollment:  *updatedEnrollment,
+	}, nil
+}
+
+// getCourse loads a course and maps a missing course to a not found error
+func (s *service) getCourse(courseID uuid.UUID) (*courses.Course, error) {
+	if courseID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	course, err := s.courseRepo.GetCourseByID(courseID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	return course, nil
+}
+
+// changeStatus moves a lesson along its lifecycle if the transition is allowed
+func (s *service) changeStatus(actorID, lessonID uuid.UUID, status string) (*Lesson, error) {
+	lesson, err := s.getManagedLesson(actorID, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := false
+	for _, next := range allowedTransitions[lesson.Status] {
+		if next == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, shared.NewAPIError(409, "Lesson cannot move from "+lesson.Status+" to "+status)
+	}
+
+	lesson.Status = status
+	lesson.UpdatedAt = time.Now()
+	if err := s.lessonsRepo.UpdateLessonStatus(lesson); err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+
+	return lesson, nil
+}
+
+// getManagedLesson loads a lesson and checks that the actor is an admin or the tutor of its course
+func (s *service) getManagedLesson(actorID, lessonID uuid.UUID) (*Lesson, error) {
+	if actorID == uuid.Nil || lessonID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	actor, err := s.getAuthor(actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	lesson, err := s.lessonsRepo.GetLessonByID(lessonID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	course, err := s.getCourse(lesson.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageCourse(actor, course) {
+		return nil, shared.ErrForbidden
+	}
+
+	return lesson, nil
+}
+
+// getAuthor loads the actor of a lesson change and applies the same verification
+// requirement as course authoring
+func (s *service) getAuthor(actorID uuid.UUID) (*shared.User, error) {
+	actor, err := s.userRepo.GetByID(actorID)
+	if err != nil {
+		return nil, shared.ErrUnauthorized
+	}
+	if err := s.verificationPolicy.Check(actor, shared.FeatureCourseAuthoring); err != nil {
+		return nil, err
+	}
+	return actor, nil
+}
+
+// canManageCourse reports whether the user may edit the lessons of the course
+func canManageCourse(u *shared.User, course *courses.Course) bool {
+	return u.Role.Can(shared.PermissionManageAllCourses) ||
+		(u.Role.Can(shared.PermissionAuthorCourses) && course.TutorID == u.ID)
 }
@@ -3,9 +3,17 @@ package lessons
 import (
 	"time"
 
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
 	"github.com/google/uuid"
 )
 
+// Lesson statuses. Lessons start as drafts and only published lessons are visible to students.
+const (
+	StatusDraft     = "draft"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
+
 type Lesson struct {
 	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;"`
 	CourseID    uuid.UUID `json:"course_id" gorm:"type:uuid;not null;foreignKey:CourseID;references:ID"`
@@ -13,6 +21,40 @@ type Lesson struct {
 	Description string    `json:"description" gorm:"type:text;not null"`
 	VideoURL    string    `json:"video_url" gorm:"type:varchar(255);not null"`
 	Duration    string    `json:"duration" gorm:"type:varchar(255);not null"`
+	Position    int       `json:"position" gorm:"not null"`
+	Status      string    `json:"status" gorm:"type:varchar(20);not null;default:draft"`
 	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamp;not null"`
 	UpdatedAt   time.Time `json:"updated_at" gorm:"type:timestamp;not null"`
 }
+
+// LessonVisibility limits which unpublished lessons a listing includes.
+// Published lessons are always included.
+type LessonVisibility struct {
+	// AllStatuses includes unpublished lessons of every course
+	AllStatuses bool
+	// OwnerID includes unpublished lessons of the courses taught by this tutor
+	OwnerID *uuid.UUID
+}
+
+// UpdateLessonRequest represents a partial update of a lesson
+type UpdateLessonRequest struct {
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	VideoURL    *string `json:"video_url,omitempty"`
+	Duration    *string `json:"duration,omitempty"`
+}
+
+// LessonCompletion records that an enrolled student completed a lesson
+type LessonCompletion struct {
+	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key;"`
+	EnrollmentID uuid.UUID `json:"enrollment_id" gorm:"type:uuid;not null"`
+	LessonID     uuid.UUID `json:"lesson_id" gorm:"type:uuid;not null"`
+	CompletedAt  time.Time `json:"completed_at" gorm:"type:timestamp;not null"`
+}
+
+// CompleteLessonResponse represents the result of completing a lesson
+type CompleteLessonResponse struct {
+	LessonID    uuid.UUID              `json:"lesson_id"`
+	CompletedAt time.Time              `json:"completed_at"`
+	Enrollment  enrollments.Enrollment `json:"enrollment"`
+}
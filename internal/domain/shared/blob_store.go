@@ -0,0 +1,14 @@
+package shared
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore defines storage of uploaded files
+type BlobStore interface {
+	// Put stores the object under key, replacing an existing one, and returns its public URL
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) (string, error)
+	// DeletePrefix removes every object whose key starts with prefix
+	DeletePrefix(ctx context.Context, prefix string) error
+}
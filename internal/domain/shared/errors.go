@@ -52,12 +52,34 @@ var (
 		Message: "Forbidden",
 	}
 
+	ErrNotEnrolled = &APIError{
+		Code:    http.StatusForbidden,
+		Message: "Not enrolled in this course",
+	}
+
+	// 404 Not Found
+	ErrNotFound = &APIError{
+		Code:    http.StatusNotFound,
+		Message: "Resource not found",
+	}
+
 	// 409 Conflict
 	ErrUserAlreadyExists = &APIError{
 		Code:    http.StatusConflict,
 		Message: "User already exists",
 	}
 
+	ErrAlreadyEnrolled = &APIError{
+		Code:    http.StatusConflict,
+		Message: "Already enrolled in this course",
+	}
+
+	// 429 Too Many Requests
+	ErrTooManyLoginAttempts = &APIError{
+		Code:    http.StatusTooManyRequests,
+		Message: "Too many failed login attempts, try again later",
+	}
+
 	// 500 Internal Server Error
 	ErrInternalServer = &APIError{
 		Code:    http.StatusInternalServerError,
@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType identifies what happened in a domain event
+type EventType string
+
+// Domain events other domains react to
+const (
+	// EventLessonCompleted is published every time a student completes a lesson, SubjectID is the lesson
+	EventLessonCompleted EventType = "lesson_completed"
+	// EventCourseCompleted is published when a student completes the last lesson of a course, SubjectID is the course
+	EventCourseCompleted EventType = "course_completed"
+	// EventFollowerGained is published when a user gets a new follower, SubjectID is the follower
+	EventFollowerGained EventType = "follower_gained"
+)
+
+// Event is something a user did that other domains may react to.
+// The same event can be published more than once, handlers must be idempotent.
+type Event struct {
+	Type       EventType
+	UserID     uuid.UUID
+	SubjectID  uuid.UUID
+	OccurredAt time.Time
+}
+
+// NewEvent creates an event that occurred now
+func NewEvent(eventType EventType, userID, subjectID uuid.UUID) Event {
+	return Event{
+		Type:       eventType,
+		UserID:     userID,
+		SubjectID:  subjectID,
+		OccurredAt: time.Now(),
+	}
+}
+
+// EventHandler reacts to a published event
+type EventHandler func(event Event) error
+
+// EventPublisher publishes domain events after the change they describe is committed
+type EventPublisher interface {
+	// Publish delivers the event to its subscribers. Failing subscribers do not fail the publisher.
+	Publish(event Event)
+}
+
+// EventBus lets domains subscribe to the events others publish
+type EventBus interface {
+	EventPublisher
+	Subscribe(eventType EventType, handler EventHandler)
+}
@@ -0,0 +1,13 @@
+package shared
+
+// EmailMessage represents an email sent to a user
+type EmailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer defines email delivery operations
+type Mailer interface {
+	Send(message *EmailMessage) error
+}
@@ -1,7 +1,29 @@
 package shared
 
+import (
+	"fmt"
+	"net/http"
+)
+
+// MinPasswordLength is the minimum number of characters of a user password
+const MinPasswordLength = 6
+
+// ErrPasswordTooShort is returned when a new password is shorter than MinPasswordLength
+var ErrPasswordTooShort = &APIError{
+	Code:    http.StatusBadRequest,
+	Message: fmt.Sprintf("Password must be at least %d characters long", MinPasswordLength),
+}
+
 // PasswordHasher defines password hashing operations
 type PasswordHasher interface {
 	HashPassword(password string) (string, error)
 	ComparePassword(password, hash string) bool
 }
+
+// ValidatePassword checks a password a user is about to set
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength {
+		return ErrPasswordTooShort
+	}
+	return nil
+}
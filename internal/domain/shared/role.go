@@ -0,0 +1,69 @@
+package shared
+
+import "slices"
+
+// Role is the role of a user account
+type Role string
+
+// Roles a user can have
+const (
+	RoleStudent Role = "student"
+	RoleTutor   Role = "tutor"
+	RoleAdmin   Role = "admin"
+)
+
+// Roles lists every role, from the least to the most privileged
+var Roles = []Role{RoleStudent, RoleTutor, RoleAdmin}
+
+// RegistrationRoles are the roles a user can pick when creating an account.
+// Admins are only appointed by other admins, the first one is promoted in the database.
+var RegistrationRoles = []Role{RoleStudent, RoleTutor}
+
+// Permission is an action a role may be allowed to perform
+type Permission string
+
+// Permissions checked by the domain services
+const (
+	// PermissionEnroll allows enrolling in courses and completing their lessons
+	PermissionEnroll Permission = "courses:enroll"
+	// PermissionAuthorCourses allows creating courses and managing the own ones
+	PermissionAuthorCourses Permission = "courses:author"
+	// PermissionManageAllCourses allows managing courses and lessons of every tutor
+	PermissionManageAllCourses Permission = "courses:manage_all"
+	// PermissionManageUsers allows editing the profile, password and status of any user
+	PermissionManageUsers Permission = "users:manage"
+	// PermissionManageRoles allows promoting and demoting users
+	PermissionManageRoles Permission = "users:manage_roles"
+	// PermissionViewSecurityEvents allows reading login lockouts and role changes
+	PermissionViewSecurityEvents Permission = "security:view_events"
+	// PermissionManageAchievements allows editing the catalog of achievements and badges
+	PermissionManageAchievements Permission = "achievements:manage"
+)
+
+// rolePermissions is the permission matrix of the roles
+var rolePermissions = map[Role][]Permission{
+	RoleStudent: {
+		PermissionEnroll,
+	},
+	RoleTutor: {
+		PermissionAuthorCourses,
+	},
+	RoleAdmin: {
+		PermissionAuthorCourses,
+		PermissionManageAllCourses,
+		PermissionManageUsers,
+		PermissionManageRoles,
+		PermissionViewSecurityEvents,
+		PermissionManageAchievements,
+	},
+}
+
+// Valid reports whether the role is one of the known roles
+func (r Role) Valid() bool {
+	return slices.Contains(Roles, r)
+}
+
+// Can reports whether the role grants the permission
+func (r Role) Can(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
@@ -0,0 +1,11 @@
+package shared
+
+// Tx is a transaction opened by a UnitOfWork. Repositories join it through their WithTx method,
+// the value itself is only meaningful to the infrastructure that created it.
+type Tx interface{}
+
+// UnitOfWork runs changes spanning several repositories atomically
+type UnitOfWork interface {
+	// Do runs fn in a transaction that is committed if fn returns nil and rolled back otherwise
+	Do(fn func(tx Tx) error) error
+}
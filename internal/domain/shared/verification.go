@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Features that can be restricted to users with a verified email
+const (
+	FeatureEnroll           = "enroll"
+	FeatureCourseAuthoring  = "course_authoring"
+	FeatureLessonCompletion = "lesson_completion"
+)
+
+// ErrEmailNotVerified is returned when an unverified user uses a feature that requires a verified email
+var ErrEmailNotVerified = &APIError{
+	Code:    http.StatusForbidden,
+	Message: "Email address is not verified",
+}
+
+// VerificationPolicy decides which features require a verified email
+type VerificationPolicy struct {
+	required map[string]bool
+}
+
+// NewVerificationPolicy creates a policy requiring a verified email for the given features
+func NewVerificationPolicy(features ...string) VerificationPolicy {
+	required := make(map[string]bool, len(features))
+	for _, feature := range features {
+		if feature = strings.TrimSpace(feature); feature != "" {
+			required[feature] = true
+		}
+	}
+	return VerificationPolicy{required: required}
+}
+
+// Check returns ErrEmailNotVerified if the feature requires a verified email and the user has none
+func (p VerificationPolicy) Check(user *User, feature string) error {
+	if p.required[feature] && !user.IsVerified {
+		return ErrEmailNotVerified
+	}
+	return nil
+}
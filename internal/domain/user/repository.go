@@ -20,6 +20,7 @@ type Repository interface {
 	CreateUserInfo(userInfo *UserInfo) error
 	UpdateUserInfo(userID uuid.UUID, userInfo *UpdateUserInfoRequest) error
 	DeleteUserInfo(userID uuid.UUID) error
+	UpdateAvatar(userID uuid.UUID, avatarURL, avatarKey string) error
 
 	// UserStats operations
 	GetUserStats(userID uuid.UUID) (*UserStats, error)
@@ -38,4 +39,27 @@ type Repository interface {
 
 	// Student status operations
 	UpdateStudentStatus(replacerID, studentID uuid.UUID, status BooleanUpdateRequest) error
+
+	// Role operations
+	// ChangeUserRole sets the new role of the change, fills in the old one and records the change.
+	// The sessions of the user are revoked so tokens carrying the old role stop working.
+	// Returns ErrRoleUnchanged if the user already has the role.
+	ChangeUserRole(change *RoleChange) error
+	// GetRoleChanges lists role changes newest first, of one user if userID is set
+	GetRoleChanges(userID *uuid.UUID, limit, offset int) ([]RoleChange, int64, error)
+
+	// Follow operations
+	// Follow records the follow and updates the counters of both users.
+	// Returns ErrAlreadyFollowing if the follow exists.
+	Follow(follow *Follow) error
+	// Unfollow removes the follow and updates the counters of both users.
+	// Returns ErrNotFollowing if the follow does not exist.
+	Unfollow(followerID, followeeID uuid.UUID) error
+	// GetFollowers lists the users following userID, most recent first
+	GetFollowers(userID uuid.UUID, limit, offset int) ([]FollowUser, int64, error)
+	// GetFollowing lists the users followed by userID, most recent first
+	GetFollowing(userID uuid.UUID, limit, offset int) ([]FollowUser, int64, error)
+
+	// WithTx returns the repository bound to a transaction of a shared.UnitOfWork
+	WithTx(tx shared.Tx) Repository
 }
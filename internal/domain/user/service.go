@@ -1,32 +1,54 @@
 package user
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/authz"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Service defines the interface for user business logic
 type Service interface {
 	GetUserInfo(userID uuid.UUID) (*UserInfo, error)
-	UpdateUserInfo(userID uuid.UUID, userInfo *UpdateUserInfoRequest) error
+	UpdateUserInfo(actorID, userID uuid.UUID, userInfo *UpdateUserInfoRequest) error
 	GetUserStats(userID uuid.UUID) (*UserStats, error)
 	GetUserAchievements(userID uuid.UUID) ([]UserAchievements, error)
 	GetUserBadges(userID uuid.UUID) ([]UserBadges, error)
-	UpdateUserPassword(userID uuid.UUID, passwordsRequest *UpdateUserPasswordRequest) error
-	UpdateStudentStatus(replacerID, studentID uuid.UUID, status BooleanUpdateRequest) error
+	UpdateUserPassword(actorID, userID uuid.UUID, passwordsRequest *UpdateUserPasswordRequest) error
+	UpdateStudentStatus(actorID, replacerID, studentID uuid.UUID, status BooleanUpdateRequest) error
+	ChangeUserRole(actorID, userID uuid.UUID, req *ChangeRoleRequest) (*RoleChange, error)
+	GetRoleChanges(actorID uuid.UUID, userID *uuid.UUID, page, limit int) (*RoleChangesPage, error)
+	UploadAvatar(ctx context.Context, actorID, userID uuid.UUID, data []byte) (*Avatar, error)
+	Follow(actorID, userID uuid.UUID) (*Follow, error)
+	Unfollow(actorID, userID uuid.UUID) error
+	GetFollowers(userID uuid.UUID, page, limit int) (*FollowsPage, error)
+	GetFollowing(userID uuid.UUID, page, limit int) (*FollowsPage, error)
 }
 
 // service implements the user business logic
 type service struct {
 	userRepo     Repository
 	passwordHash shared.PasswordHasher
+	policy       authz.Policy
+	blobStore    shared.BlobStore
+	images       ImageProcessor
+	events       shared.EventPublisher
 }
 
 // NewService creates a new user service
-func NewService(userRepo Repository, passwordHash shared.PasswordHasher) Service {
+func NewService(userRepo Repository, passwordHash shared.PasswordHasher, policy authz.Policy, blobStore shared.BlobStore, images ImageProcessor, events shared.EventPublisher) Service {
 	return &service{
 		userRepo:     userRepo,
 		passwordHash: passwordHash,
+		policy:       policy,
+		blobStore:    blobStore,
+		images:       images,
+		events:       events,
 	}
 }
 
@@ -44,12 +66,20 @@ func (s *service) GetUserInfo(userID uuid.UUID) (*UserInfo, error) {
 	return userInfo, nil
 }
 
-// UpdateUserInfo updates user information
-func (s *service) UpdateUserInfo(userID uuid.UUID, userInfo *UpdateUserInfoRequest) error {
+// UpdateUserInfo updates user information, users can only update their own profile unless they are an admin
+func (s *service) UpdateUserInfo(actorID, userID uuid.UUID, userInfo *UpdateUserInfoRequest) error {
 	if userID == uuid.Nil {
 		return shared.ErrInvalidInput
 	}
 
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return err
+	}
+	if err := s.policy.CanManageUser(actor, userID); err != nil {
+		return err
+	}
+
 	if userInfo == nil {
 		return shared.ErrMissingFields
 	}
@@ -59,7 +89,7 @@ func (s *service) UpdateUserInfo(userID uuid.UUID, userInfo *UpdateUserInfoReque
 		return shared.ErrMissingFields
 	}
 
-	err := s.userRepo.UpdateUserInfo(userID, userInfo)
+	err = s.userRepo.UpdateUserInfo(userID, userInfo)
 	if err != nil {
 		return shared.ErrDatabaseError
 	}
@@ -109,8 +139,9 @@ func (s *service) GetUserBadges(userID uuid.UUID) ([]UserBadges, error) {
 	return badges, nil
 }
 
-// UpdateUserPassword updates user password
-func (s *service) UpdateUserPassword(userID uuid.UUID, passwordsRequest *UpdateUserPasswordRequest) error {
+// UpdateUserPassword updates user password. Users changing their own password must confirm
+// the current one, admins can reset the password of any user.
+func (s *service) UpdateUserPassword(actorID, userID uuid.UUID, passwordsRequest *UpdateUserPasswordRequest) error {
 	if userID == uuid.Nil {
 		return shared.ErrInvalidInput
 	}
@@ -118,13 +149,24 @@ func (s *service) UpdateUserPassword(userID uuid.UUID, passwordsRequest *UpdateU
 	if passwordsRequest == nil {
 		return shared.ErrMissingFields
 	}
+	if err := shared.ValidatePassword(passwordsRequest.NewPassword); err != nil {
+		return err
+	}
 
-	user, err := s.userRepo.GetByID(userID)
+	actor, err := s.getActor(actorID)
 	if err != nil {
-		return shared.ErrDatabaseError
+		return err
+	}
+	if err := s.policy.CanManageUser(actor, userID); err != nil {
+		return err
+	}
+
+	user, err := s.getUser(userID)
+	if err != nil {
+		return err
 	}
 
-	if s.passwordHash.ComparePassword(passwordsRequest.CurrentPassword, user.Password) {
+	if actor.ID == user.ID && !s.passwordHash.ComparePassword(passwordsRequest.CurrentPassword, user.Password) {
 		return shared.ErrInvalidCredentials
 	}
 
@@ -141,7 +183,9 @@ func (s *service) UpdateUserPassword(userID uuid.UUID, passwordsRequest *UpdateU
 	return nil
 }
 
-func (s *service) UpdateStudentStatus(replacerID, studentID uuid.UUID, status BooleanUpdateRequest) error {
+// UpdateStudentStatus activates, deactivates or verifies a student. The replacer must be the
+// acting user, who has to be an admin or a tutor of the student.
+func (s *service) UpdateStudentStatus(actorID, replacerID, studentID uuid.UUID, status BooleanUpdateRequest) error {
 	if replacerID == uuid.Nil || studentID == uuid.Nil {
 		return shared.ErrInvalidInput
 	}
@@ -150,10 +194,288 @@ func (s *service) UpdateStudentStatus(replacerID, studentID uuid.UUID, status Bo
 		return shared.ErrMissingFields
 	}
 
-	err := s.userRepo.UpdateStudentStatus(replacerID, studentID, status)
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return err
+	}
+	if err := s.policy.CanManageUser(actor, replacerID); err != nil {
+		return err
+	}
+
+	student, err := s.getUser(studentID)
+	if err != nil {
+		return err
+	}
+	if err := s.policy.CanChangeStudentStatus(actor, student); err != nil {
+		return err
+	}
+
+	err = s.userRepo.UpdateStudentStatus(replacerID, studentID, status)
 	if err != nil {
 		return shared.ErrDatabaseError
 	}
 
 	return nil
 }
+
+// ChangeUserRole promotes or demotes a user and records who did it and why.
+// Admins cannot change their own role, so the last admin cannot lock everyone out.
+func (s *service) ChangeUserRole(actorID, userID uuid.UUID, req *ChangeRoleRequest) (*RoleChange, error) {
+	if userID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+	if req == nil || req.Role == "" {
+		return nil, shared.ErrMissingFields
+	}
+	if !req.Role.Valid() {
+		return nil, shared.NewAPIError(400, "Invalid role. Must be one of: student, tutor, admin")
+	}
+
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Role.Can(shared.PermissionManageRoles) {
+		return nil, shared.ErrForbidden
+	}
+	if actor.ID == userID {
+		return nil, shared.NewAPIError(409, "You cannot change your own role")
+	}
+
+	change := &RoleChange{
+		ID:        uuid.New(),
+		UserID:    userID,
+		ChangedBy: actor.ID,
+		NewRole:   req.Role,
+		Reason:    strings.TrimSpace(req.Reason),
+	}
+	if err := s.userRepo.ChangeUserRole(change); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, shared.ErrNotFound
+		case errors.Is(err, ErrRoleUnchanged):
+			return nil, shared.NewAPIError(409, "User already has this role")
+		default:
+			return nil, shared.ErrDatabaseError
+		}
+	}
+
+	return change, nil
+}
+
+// GetRoleChanges returns the role change history to administrators
+func (s *service) GetRoleChanges(actorID uuid.UUID, userID *uuid.UUID, page, limit int) (*RoleChangesPage, error) {
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Role.Can(shared.PermissionViewSecurityEvents) {
+		return nil, shared.ErrForbidden
+	}
+
+	page, limit, err = pageBounds(page, limit, defaultRoleChangesLimit, maxRoleChangesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, total, err := s.userRepo.GetRoleChanges(userID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return &RoleChangesPage{
+		Changes: changes,
+		Page:    page,
+		Limit:   limit,
+		Total:   int(total),
+	}, nil
+}
+
+// UploadAvatar resizes an uploaded image into the avatar variants, stores them and
+// replaces the previous avatar of the user
+func (s *service) UploadAvatar(ctx context.Context, actorID, userID uuid.UUID, data []byte) (*Avatar, error) {
+	if userID == uuid.Nil {
+		return nil, shared.ErrInvalidInput
+	}
+
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.policy.CanManageUser(actor, userID); err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, shared.ErrMissingFields
+	}
+	if len(data) > MaxAvatarBytes {
+		return nil, shared.NewAPIError(413, "Avatar file is too large")
+	}
+
+	userInfo, err := s.userRepo.GetUserInfo(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	variants, err := s.images.ResizeSquare(data, AvatarVariantSizes)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUnsupportedImage):
+			return nil, shared.NewAPIError(400, "Avatar must be a JPEG or PNG image")
+		case errors.Is(err, ErrImageTooLarge):
+			return nil, shared.NewAPIError(413, "Avatar dimensions are too large")
+		default:
+			return nil, shared.ErrInternalServer
+		}
+	}
+
+	// Every upload gets a new prefix so cached URLs of the previous avatar are never served stale content
+	avatarKey := "avatars/" + userID.String() + "/" + uuid.NewString()
+	avatar := &Avatar{Variants: make(map[string]string, len(variants))}
+	for _, variant := range variants {
+		key := avatarKey + "/" + variant.Name + "." + variant.Extension
+		url, err := s.blobStore.Put(ctx, key, bytes.NewReader(variant.Data), int64(len(variant.Data)), variant.ContentType)
+		if err != nil {
+			s.deleteAvatar(ctx, avatarKey)
+			return nil, shared.ErrInternalServer
+		}
+		avatar.Variants[variant.Name] = url
+	}
+	avatar.URL = avatar.Variants[AvatarVariant]
+
+	if err := s.userRepo.UpdateAvatar(userID, avatar.URL, avatarKey); err != nil {
+		s.deleteAvatar(ctx, avatarKey)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	if userInfo.AvatarKey != "" {
+		s.deleteAvatar(ctx, userInfo.AvatarKey)
+	}
+
+	return avatar, nil
+}
+
+// deleteAvatar removes stored avatar variants, failures only leave orphaned files behind
+func (s *service) deleteAvatar(ctx context.Context, avatarKey string) {
+	_ = s.blobStore.DeletePrefix(ctx, avatarKey+"/")
+}
+
+// Follow makes the actor follow a user
+func (s *service) Follow(actorID, userID uuid.UUID) (*Follow, error) {
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.ID == userID {
+		return nil, shared.NewAPIError(400, "You cannot follow yourself")
+	}
+	if _, err := s.getUser(userID); err != nil {
+		return nil, err
+	}
+
+	follow := &Follow{FollowerID: actor.ID, FolloweeID: userID}
+	if err := s.userRepo.Follow(follow); err != nil {
+		if errors.Is(err, ErrAlreadyFollowing) {
+			return nil, shared.NewAPIError(409, "You already follow this user")
+		}
+		return nil, shared.ErrDatabaseError
+	}
+
+	s.events.Publish(shared.NewEvent(shared.EventFollowerGained, userID, actor.ID))
+	return follow, nil
+}
+
+// Unfollow makes the actor stop following a user
+func (s *service) Unfollow(actorID, userID uuid.UUID) error {
+	actor, err := s.getActor(actorID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Unfollow(actor.ID, userID); err != nil {
+		if errors.Is(err, ErrNotFollowing) {
+			return shared.NewAPIError(404, "You do not follow this user")
+		}
+		return shared.ErrDatabaseError
+	}
+	return nil
+}
+
+// GetFollowers returns a page of the users following a user
+func (s *service) GetFollowers(userID uuid.UUID, page, limit int) (*FollowsPage, error) {
+	return s.getFollows(userID, page, limit, s.userRepo.GetFollowers)
+}
+
+// GetFollowing returns a page of the users a user follows
+func (s *service) GetFollowing(userID uuid.UUID, page, limit int) (*FollowsPage, error) {
+	return s.getFollows(userID, page, limit, s.userRepo.GetFollowing)
+}
+
+// getFollows validates the page and lists follows of an existing user with list
+func (s *service) getFollows(userID uuid.UUID, page, limit int, list func(userID uuid.UUID, limit, offset int) ([]FollowUser, int64, error)) (*FollowsPage, error) {
+	page, limit, err := pageBounds(page, limit, defaultFollowsLimit, maxFollowsLimit)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getUser(userID); err != nil {
+		return nil, err
+	}
+
+	users, total, err := list(userID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, shared.ErrDatabaseError
+	}
+	return &FollowsPage{
+		Users: users,
+		Page:  page,
+		Limit: limit,
+		Total: int(total),
+	}, nil
+}
+
+// pageBounds applies the defaults of unset pagination parameters and validates them
+func pageBounds(page, limit, defaultLimit, maxLimit int) (int, int, error) {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if page < 1 || limit < 1 || limit > maxLimit {
+		return 0, 0, shared.ErrInvalidInput
+	}
+	return page, limit, nil
+}
+
+// getActor loads the authenticated user a request is made by
+func (s *service) getActor(actorID uuid.UUID) (*shared.User, error) {
+	if actorID == uuid.Nil {
+		return nil, shared.ErrUnauthorized
+	}
+	actor, err := s.userRepo.GetByID(actorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrUnauthorized
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	return actor, nil
+}
+
+// getUser loads the user an action targets
+func (s *service) getUser(userID uuid.UUID) (*shared.User, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, shared.ErrDatabaseError
+	}
+	return user, nil
+}
@@ -1,6 +1,10 @@
 package user
 
 import (
+	"errors"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
 	"github.com/google/uuid"
 )
 
@@ -14,6 +18,8 @@ type UserInfo struct {
 	Bio       string    `json:"bio" gorm:"type:text;not null"`
 	Location  string    `json:"location" gorm:"type:varchar(255);not null"`
 	Phone     string    `json:"phone" gorm:"type:varchar(255);not null"`
+	// AvatarKey is the blob store prefix of the avatar variants, used to remove them on replacement
+	AvatarKey string `json:"-" gorm:"type:varchar(255);not null"`
 }
 
 // UpdateUserInfoRequest represents the request to update user info
@@ -44,18 +50,44 @@ type UserStats struct {
 	NextLevelXP       int       `json:"next_level_xp" gorm:"type:int;not null"`
 }
 
-// UserAchievements represents user achievements
+// Progress of a new account
+const (
+	InitialLevel       = 1
+	InitialNextLevelXP = 100
+)
+
+// NewUserStats returns the statistics a new account starts with
+func NewUserStats(userID uuid.UUID) *UserStats {
+	return &UserStats{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Level:       InitialLevel,
+		NextLevelXP: InitialNextLevelXP,
+	}
+}
+
+// UserAchievements represents user achievements.
+// Achievements granted from the catalog reference their definition, whose description and icon are read along.
 type UserAchievements struct {
-	ID              uuid.UUID `json:"id" gorm:"type:uuid;primary_key;"`
-	UserID          uuid.UUID `json:"user_id" gorm:"type:uuid;not null;foreignKey:UserID;references:ID"`
-	AchievementName string    `json:"achievement_name" gorm:"type:varchar(255);not null"`
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	UserID          uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;foreignKey:UserID;references:ID"`
+	AchievementID   *uuid.UUID `json:"achievement_id,omitempty" gorm:"type:uuid"`
+	AchievementName string     `json:"achievement_name" gorm:"type:varchar(255);not null"`
+	Description     string     `json:"description" gorm:"->"`
+	Icon            string     `json:"icon" gorm:"->"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime"`
 }
 
-// UserBadges represents user badges
+// UserBadges represents user badges.
+// Badges granted from the catalog reference their definition, whose description and icon are read along.
 type UserBadges struct {
-	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;"`
-	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;foreignKey:UserID;references:ID"`
-	BadgeName string    `json:"badge_name" gorm:"type:varchar(255);not null"`
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;"`
+	UserID      uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;foreignKey:UserID;references:ID"`
+	BadgeID     *uuid.UUID `json:"badge_id,omitempty" gorm:"type:uuid"`
+	BadgeName   string     `json:"badge_name" gorm:"type:varchar(255);not null"`
+	Description string     `json:"description" gorm:"->"`
+	Icon        string     `json:"icon" gorm:"->"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
 }
 
 type UpdateStudentStatusRequest struct {
@@ -67,3 +99,119 @@ type BooleanUpdateRequest struct {
 	IsActive   *bool `json:"is_active,omitempty"`
 	IsVerified *bool `json:"is_verified,omitempty"`
 }
+
+// RoleChange is the audit record of an admin promoting or demoting a user
+type RoleChange struct {
+	ID        uuid.UUID   `json:"id" gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID   `json:"user_id" gorm:"type:uuid;not null"`
+	ChangedBy uuid.UUID   `json:"changed_by" gorm:"type:uuid;not null"`
+	OldRole   shared.Role `json:"old_role" gorm:"not null"`
+	NewRole   shared.Role `json:"new_role" gorm:"not null"`
+	Reason    string      `json:"reason" gorm:"not null"`
+	CreatedAt time.Time   `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// ChangeRoleRequest represents the request of an admin to change the role of a user
+type ChangeRoleRequest struct {
+	Role   shared.Role `json:"role" validate:"required,oneof=student tutor admin"`
+	Reason string      `json:"reason"`
+}
+
+// Page sizes of the role change list
+const (
+	defaultRoleChangesLimit = 20
+	maxRoleChangesLimit     = 100
+)
+
+// RoleChangesPage is a page of role changes, newest first
+type RoleChangesPage struct {
+	Changes []RoleChange `json:"changes"`
+	Page    int          `json:"page"`
+	Limit   int          `json:"limit"`
+	Total   int          `json:"total"`
+}
+
+// ErrRoleUnchanged is returned when a user already has the requested role
+var ErrRoleUnchanged = errors.New("user already has this role")
+
+// Follow is a user following another one. The Followers and Following counters of
+// UserStats are kept in sync with these rows.
+type Follow struct {
+	FollowerID uuid.UUID `json:"follower_id" gorm:"type:uuid;primaryKey"`
+	FolloweeID uuid.UUID `json:"followee_id" gorm:"type:uuid;primaryKey"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// FollowUser is an entry of a follower or following list
+type FollowUser struct {
+	UserID     uuid.UUID `json:"user_id"`
+	FirstName  string    `json:"first_name"`
+	LastName   string    `json:"last_name"`
+	Avatar     string    `json:"avatar"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+// Page sizes of follower and following lists
+const (
+	defaultFollowsLimit = 20
+	maxFollowsLimit     = 100
+)
+
+// FollowsPage is a page of followers or followed users, most recent follows first
+type FollowsPage struct {
+	Users []FollowUser `json:"users"`
+	Page  int          `json:"page"`
+	Limit int          `json:"limit"`
+	Total int          `json:"total"`
+}
+
+// Errors returned by follow operations of the repository
+var (
+	ErrAlreadyFollowing = errors.New("user is already followed")
+	ErrNotFollowing     = errors.New("user is not followed")
+)
+
+// Limits of uploaded avatars
+const (
+	MaxAvatarBytes = 5 << 20
+	// MaxAvatarPixels bounds the decoded size so small files cannot expand into huge images
+	MaxAvatarPixels = 4096 * 4096
+)
+
+// AvatarVariantSizes are the square sizes, in pixels, avatars are resized to
+var AvatarVariantSizes = map[string]int{
+	"large":  512,
+	"medium": 256,
+	"small":  64,
+}
+
+// AvatarVariant is the profile picture of the user, the large variant is stored on UserInfo.Avatar
+const AvatarVariant = "large"
+
+// ImageVariant is a resized image ready to be stored
+type ImageVariant struct {
+	Name        string
+	Data        []byte
+	ContentType string
+	Extension   string
+}
+
+// ImageProcessor turns uploaded images into resized variants
+type ImageProcessor interface {
+	// ResizeSquare decodes a JPEG or PNG image and returns one square variant per size,
+	// re-encoded without the metadata of the original such as EXIF.
+	// It returns ErrUnsupportedImage for other content and ErrImageTooLarge past MaxAvatarPixels.
+	ResizeSquare(data []byte, sizes map[string]int) ([]ImageVariant, error)
+}
+
+// Avatar is the result of an avatar upload
+type Avatar struct {
+	URL      string            `json:"url"`
+	Variants map[string]string `json:"variants"`
+}
+
+// Errors returned by ImageProcessor implementations
+var (
+	ErrUnsupportedImage = errors.New("unsupported image format")
+	ErrImageTooLarge    = errors.New("image dimensions are too large")
+)
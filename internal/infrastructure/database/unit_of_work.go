@@ -0,0 +1,23 @@
+package database
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// unitOfWork implements shared.UnitOfWork with gorm transactions
+type unitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork creates a unit of work whose transactions are *gorm.DB values
+func NewUnitOfWork(db *gorm.DB) shared.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+// Do runs fn in a database transaction
+func (u *unitOfWork) Do(fn func(tx shared.Tx) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(tx)
+	})
+}
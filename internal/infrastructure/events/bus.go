@@ -0,0 +1,40 @@
+package events
+
+import (
+	"log"
+	"sync"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+)
+
+// bus implements the shared.EventBus interface in process.
+// Handlers run synchronously in the publishing request, in the order they subscribed.
+type bus struct {
+	mu       sync.RWMutex
+	handlers map[shared.EventType][]shared.EventHandler
+}
+
+// NewBus creates an in-process event bus
+func NewBus() shared.EventBus {
+	return &bus{handlers: make(map[shared.EventType][]shared.EventHandler)}
+}
+
+// Subscribe registers a handler for an event type
+func (b *bus) Subscribe(eventType shared.EventType, handler shared.EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish runs the handlers of the event, logging the ones that fail
+func (b *bus) Publish(event shared.Event) {
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(event); err != nil {
+			log.Printf("Event %s of user %s: handler failed: %v", event.Type, event.UserID, err)
+		}
+	}
+}
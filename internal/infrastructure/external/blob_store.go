@@ -0,0 +1,182 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// localBlobStore implements the shared.BlobStore interface on the local filesystem.
+// The directory is expected to be served under publicURL.
+type localBlobStore struct {
+	dir       string
+	publicURL string
+}
+
+// NewLocalBlobStore creates a blob store writing to dir
+func NewLocalBlobStore(dir, publicURL string) shared.BlobStore {
+	return &localBlobStore{dir: dir, publicURL: strings.TrimSuffix(publicURL, "/")}
+}
+
+// Put writes the object to a file named after its key
+func (s *localBlobStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial object
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	return s.publicURL + "/" + key, nil
+}
+
+// DeletePrefix removes the files under the prefix, which must name a directory
+func (s *localBlobStore) DeletePrefix(ctx context.Context, prefix string) error {
+	path, err := s.path(strings.TrimSuffix(prefix, "/"))
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return fmt.Errorf("failed to delete blobs: %w", err)
+	}
+	return nil
+}
+
+// path maps a key to a file inside the store directory
+func (s *localBlobStore) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, cleaned), nil
+}
+
+// S3Config configures an S3 compatible blob store such as AWS S3 or MinIO
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+	// PublicURL is the base URL objects are served from, defaults to <endpoint>/<bucket>
+	PublicURL string
+}
+
+// s3BlobStore implements the shared.BlobStore interface on an S3 compatible service
+type s3BlobStore struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+// NewS3BlobStore creates a blob store writing to an S3 bucket. A missing bucket is created
+// with anonymous read access, since stored objects are linked to directly.
+func NewS3BlobStore(ctx context.Context, config S3Config) (shared.BlobStore, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, errors.New("S3 endpoint and bucket are required")
+	}
+
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check S3 bucket %s: %w", config.Bucket, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, config.Bucket, minio.MakeBucketOptions{Region: config.Region}); err != nil {
+			return nil, fmt.Errorf("failed to create S3 bucket %s: %w", config.Bucket, err)
+		}
+		policy := fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::%s/*"]}]}`, config.Bucket)
+		if err := client.SetBucketPolicy(ctx, config.Bucket, policy); err != nil {
+			return nil, fmt.Errorf("failed to make S3 bucket %s public: %w", config.Bucket, err)
+		}
+	}
+
+	publicURL := config.PublicURL
+	if publicURL == "" {
+		scheme := "http"
+		if config.UseSSL {
+			scheme = "https"
+		}
+		publicURL = (&url.URL{Scheme: scheme, Host: config.Endpoint, Path: "/" + config.Bucket}).String()
+	}
+
+	return &s3BlobStore{
+		client:    client,
+		bucket:    config.Bucket,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+	}, nil
+}
+
+// Put uploads the object to the bucket
+func (s *s3BlobStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) (string, error) {
+	if _, err := s.client.PutObject(ctx, s.bucket, key, body, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	}); err != nil {
+		return "", fmt.Errorf("failed to upload blob: %w", err)
+	}
+	return s.publicURL + "/" + key, nil
+}
+
+// DeletePrefix removes every object of the bucket under the prefix
+func (s *s3BlobStore) DeletePrefix(ctx context.Context, prefix string) error {
+	objects := s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+	for result := range s.client.RemoveObjects(ctx, s.bucket, objectKeys(objects), minio.RemoveObjectsOptions{}) {
+		if result.Err != nil {
+			return fmt.Errorf("failed to delete blob %s: %w", result.ObjectName, result.Err)
+		}
+	}
+	return nil
+}
+
+// objectKeys forwards listed objects to RemoveObjects
+func objectKeys(listed <-chan minio.ObjectInfo) <-chan minio.ObjectInfo {
+	objects := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objects)
+		for object := range listed {
+			if object.Err == nil {
+				objects <- object
+			}
+		}
+	}()
+	return objects
+}
@@ -0,0 +1,73 @@
+package external
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/disintegration/imaging"
+)
+
+const jpegQuality = 85
+
+// imageProcessor implements the user.ImageProcessor interface
+type imageProcessor struct{}
+
+// NewImageProcessor creates a new image processor
+func NewImageProcessor() user.ImageProcessor {
+	return &imageProcessor{}
+}
+
+// ResizeSquare crops the center of the image to a square of every requested size.
+// Images are decoded and encoded again, so EXIF and other metadata of the upload are dropped;
+// the EXIF orientation is applied first so photos keep their intended rotation.
+func (p *imageProcessor) ResizeSquare(data []byte, sizes map[string]int) ([]user.ImageVariant, error) {
+	contentType := http.DetectContentType(data)
+	var format imaging.Format
+	var extension string
+	switch contentType {
+	case "image/jpeg":
+		format, extension = imaging.JPEG, "jpg"
+	case "image/png":
+		format, extension = imaging.PNG, "png"
+	default:
+		return nil, user.ErrUnsupportedImage
+	}
+
+	// Check the dimensions from the header before allocating the decoded image
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, user.ErrUnsupportedImage
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, user.ErrUnsupportedImage
+	}
+	if config.Width*config.Height > user.MaxAvatarPixels {
+		return nil, user.ErrImageTooLarge
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, user.ErrUnsupportedImage
+	}
+
+	variants := make([]user.ImageVariant, 0, len(sizes))
+	for name, size := range sizes {
+		resized := imaging.Fill(img, size, size, imaging.Center, imaging.Lanczos)
+
+		var buf bytes.Buffer
+		if err := imaging.Encode(&buf, resized, format, imaging.JPEGQuality(jpegQuality), imaging.PNGCompressionLevel(png.BestCompression)); err != nil {
+			return nil, fmt.Errorf("failed to encode %s avatar: %w", name, err)
+		}
+		variants = append(variants, user.ImageVariant{
+			Name:        name,
+			Data:        buf.Bytes(),
+			ContentType: contentType,
+			Extension:   extension,
+		})
+	}
+	return variants, nil
+}
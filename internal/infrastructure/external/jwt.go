@@ -2,7 +2,7 @@ package external
 
 import (
 	"fmt"
-	"os"
+	"sort"
 	"time"
 
 	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
@@ -10,44 +10,68 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	accessTokenTTL  = time.Hour * 24
+	refreshTokenTTL = time.Hour * 24 * 7
+)
+
 // jwtService implements the auth.TokenGenerator interface
 type jwtService struct {
-	secretKey string
+	keys   map[string]*signingKey
+	active *signingKey
 }
 
-// NewJWTService creates a new JWT service
-func NewJWTService() auth.TokenGenerator {
-	return &jwtService{
-		secretKey: getJWTSecret(),
+// NewJWTService creates a new JWT service.
+// It fails if no signing key is configured, unless JWT_DEV_MODE is enabled.
+func NewJWTService() (auth.TokenGenerator, error) {
+	keys, active, err := loadSigningKeys()
+	if err != nil {
+		return nil, err
 	}
+	return &jwtService{
+		keys:   keys,
+		active: active,
+	}, nil
+}
+
+// jwtClaims is the JWT representation of auth.TokenClaims
+type jwtClaims struct {
+	Role      string         `json:"role"`
+	Type      auth.TokenType `json:"type"`
+	SessionID string         `json:"sid,omitempty"`
+	jwt.RegisteredClaims
 }
 
 // GenerateToken generates access and refresh tokens
-func (j *jwtService) GenerateToken(userID uuid.UUID, role string) (*auth.LoginResponse, error) {
+func (j *jwtService) GenerateToken(userID uuid.UUID, role string, sessionID, refreshTokenID uuid.UUID) (*auth.LoginResponse, error) {
+	now := time.Now()
+
 	// Generate access token
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":  userID.String(),
-		"role": role,
-		"iat":  time.Now().Unix(),
-		"exp":  time.Now().Add(time.Hour * 24).Unix(),
-		"type": "access",
+	accessTokenString, err := j.sign(jwtClaims{
+		Role:      role,
+		Type:      auth.TokenTypeAccess,
+		SessionID: sessionID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(accessTokenTTL)),
+		},
 	})
-
-	accessTokenString, err := accessToken.SignedString([]byte(j.secretKey))
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign access token: %w", err)
 	}
 
 	// Generate refresh token
-	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":  userID.String(),
-		"role": role,
-		"iat":  time.Now().Unix(),
-		"exp":  time.Now().Add(time.Hour * 24 * 7).Unix(),
-		"type": "refresh",
+	refreshTokenString, err := j.sign(jwtClaims{
+		Role: role,
+		Type: auth.TokenTypeRefresh,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        refreshTokenID.String(),
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(refreshTokenTTL)),
+		},
 	})
-
-	refreshTokenString, err := refreshToken.SignedString([]byte(j.secretKey))
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign refresh token: %w", err)
 	}
@@ -58,38 +82,114 @@ func (j *jwtService) GenerateToken(userID uuid.UUID, role string) (*auth.LoginRe
 	}, nil
 }
 
-// ParseToken parses and validates a JWT token
-func (j *jwtService) ParseToken(tokenString string) (map[string]interface{}, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(j.secretKey), nil
+// GenerateChallengeToken generates a short-lived token for the second step of a login
+func (j *jwtService) GenerateChallengeToken(userID uuid.UUID, role string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	token, err := j.sign(jwtClaims{
+		Role: role,
+		Type: auth.TokenTypeTwoFactorChallenge,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
 	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign challenge token: %w", err)
+	}
+	return token, nil
+}
 
+// RefreshTokenTTL returns how long issued refresh tokens stay valid
+func (j *jwtService) RefreshTokenTTL() time.Duration {
+	return refreshTokenTTL
+}
+
+// ParseToken parses and validates a JWT token of the expected type
+func (j *jwtService) ParseToken(tokenString string, expectedType auth.TokenType) (*auth.TokenClaims, error) {
+	var claims jwtClaims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, j.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithExpirationRequired())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		return claims, nil
+	if claims.Type != expectedType {
+		return nil, auth.ErrUnexpectedTokenType
 	}
 
-	return nil, fmt.Errorf("invalid token")
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject: %w", err)
+	}
+
+	parsed := &auth.TokenClaims{
+		UserID:    userID,
+		Role:      claims.Role,
+		Type:      claims.Type,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	if claims.IssuedAt != nil {
+		parsed.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ID != "" {
+		if parsed.TokenID, err = uuid.Parse(claims.ID); err != nil {
+			return nil, fmt.Errorf("invalid token id: %w", err)
+		}
+	}
+	if claims.SessionID != "" {
+		if parsed.SessionID, err = uuid.Parse(claims.SessionID); err != nil {
+			return nil, fmt.Errorf("invalid session id: %w", err)
+		}
+	}
+
+	return parsed, nil
 }
 
-// ValidateToken validates a token and returns user ID and role
-func (j *jwtService) ValidateToken(tokenString string) error {
-	_, err := j.ParseToken(tokenString)
+// ValidateToken validates a token of the expected type
+func (j *jwtService) ValidateToken(tokenString string, expectedType auth.TokenType) error {
+	_, err := j.ParseToken(tokenString, expectedType)
 	return err
 }
 
-// getJWTSecret retrieves JWT secret from environment
-func getJWTSecret() string {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		// In production, this should be a proper secret
-		secret = "default-secret-key-change-in-production"
+// PublicKeys returns the public keys tokens may be signed with
+func (j *jwtService) PublicKeys() auth.JSONWebKeySet {
+	kids := make([]string, 0, len(j.keys))
+	for kid := range j.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	set := auth.JSONWebKeySet{Keys: make([]auth.JSONWebKey, 0, len(kids))}
+	for _, kid := range kids {
+		set.Keys = append(set.Keys, j.keys[kid].jwk())
+	}
+	return set
+}
+
+// sign signs the claims with the active key
+func (j *jwtService) sign(claims jwtClaims) (string, error) {
+	token := jwt.NewWithClaims(j.active.method, claims)
+	token.Header["kid"] = j.active.id
+	return token.SignedString(j.active.private)
+}
+
+// verificationKey looks up the key a token was signed with by its kid header
+func (j *jwtService) verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing kid header")
+	}
+	key, ok := j.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %q for key %q", token.Method.Alg(), kid)
 	}
-	return secret
+	return key.public, nil
 }
@@ -0,0 +1,223 @@
+package external
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Environment variables configuring JWT signing keys
+const (
+	// JWT_KEYS_DIR is a directory of <kid>.pem files. Private keys can sign and verify,
+	// public keys only verify tokens signed before a rotation.
+	envKeysDir = "JWT_KEYS_DIR"
+	// JWT_PRIVATE_KEY holds a single PEM private key, identified by JWT_KEY_ID
+	envPrivateKey = "JWT_PRIVATE_KEY"
+	envKeyID      = "JWT_KEY_ID"
+	// JWT_ACTIVE_KID selects the key new tokens are signed with
+	envActiveKeyID = "JWT_ACTIVE_KID"
+	// JWT_DEV_MODE=true allows starting with an ephemeral key when none is configured
+	envDevMode = "JWT_DEV_MODE"
+)
+
+const minRSAKeyBits = 2048
+
+// signingKey is a key tokens are signed or verified with
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.Signer // nil for keys that are only kept to verify older tokens
+	public  crypto.PublicKey
+}
+
+// jwk returns the public part of the key in JWK format
+func (k *signingKey) jwk() auth.JSONWebKey {
+	key := auth.JSONWebKey{
+		Kid: k.id,
+		Use: "sig",
+		Alg: k.method.Alg(),
+	}
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		key.Kty = "RSA"
+		key.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		key.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		key.Kty = "OKP"
+		key.Crv = "Ed25519"
+		key.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return key
+}
+
+// loadSigningKeys loads the configured keys and picks the active one
+func loadSigningKeys() (map[string]*signingKey, *signingKey, error) {
+	keys := make(map[string]*signingKey)
+
+	if dir := os.Getenv(envKeysDir); dir != "" {
+		if err := loadKeysDir(dir, keys); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if keyPEM := os.Getenv(envPrivateKey); keyPEM != "" {
+		kid := os.Getenv(envKeyID)
+		if kid == "" {
+			return nil, nil, fmt.Errorf("%s is required when %s is set", envKeyID, envPrivateKey)
+		}
+		key, err := parseSigningKey(kid, []byte(keyPEM))
+		if err != nil {
+			return nil, nil, err
+		}
+		if key.private == nil {
+			return nil, nil, fmt.Errorf("%s must contain a private key", envPrivateKey)
+		}
+		if _, exists := keys[kid]; exists {
+			return nil, nil, fmt.Errorf("duplicate JWT key id %q", kid)
+		}
+		keys[kid] = key
+	}
+
+	if len(keys) == 0 {
+		if os.Getenv(envDevMode) != "true" {
+			return nil, nil, fmt.Errorf("no JWT signing keys configured: set %s or %s, or %s=true for development", envKeysDir, envPrivateKey, envDevMode)
+		}
+		key, err := newEphemeralKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Printf("JWT dev mode: signing with ephemeral key %q, tokens will not survive a restart", key.id)
+		keys[key.id] = key
+		return keys, key, nil
+	}
+
+	active, err := activeKey(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, active, nil
+}
+
+// loadKeysDir loads every <kid>.pem file of the directory
+func loadKeysDir(dir string, keys map[string]*signingKey) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return fmt.Errorf("failed to list JWT keys: %w", err)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read JWT key %s: %w", path, err)
+		}
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := parseSigningKey(kid, data)
+		if err != nil {
+			return err
+		}
+		keys[kid] = key
+	}
+	return nil
+}
+
+// activeKey returns the key selected by JWT_ACTIVE_KID, or the only private key if it is not set
+func activeKey(keys map[string]*signingKey) (*signingKey, error) {
+	if kid := os.Getenv(envActiveKeyID); kid != "" {
+		key, ok := keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("active JWT key %q not found", kid)
+		}
+		if key.private == nil {
+			return nil, fmt.Errorf("active JWT key %q has no private key", kid)
+		}
+		return key, nil
+	}
+
+	var signers []string
+	for kid, key := range keys {
+		if key.private != nil {
+			signers = append(signers, kid)
+		}
+	}
+	sort.Strings(signers)
+	switch len(signers) {
+	case 0:
+		return nil, errors.New("no JWT private key configured")
+	case 1:
+		return keys[signers[0]], nil
+	default:
+		return nil, fmt.Errorf("%s is required when several JWT private keys are configured: %s", envActiveKeyID, strings.Join(signers, ", "))
+	}
+}
+
+// parseSigningKey parses a PEM encoded RSA or Ed25519 private or public key
+func parseSigningKey(kid string, data []byte) (*signingKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("JWT key %q is not PEM encoded", kid)
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("JWT key %q has unsupported PEM type %q", kid, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT key %q: %w", kid, err)
+	}
+
+	key := &signingKey{id: kid}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.method, key.private, key.public = jwt.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.method, key.public = jwt.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.method, key.private, key.public = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.method, key.public = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("JWT key %q must be an RSA or Ed25519 key", kid)
+	}
+
+	if rsaKey, ok := key.public.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("JWT key %q must be at least %d bits", kid, minRSAKeyBits)
+	}
+
+	return key, nil
+}
+
+// newEphemeralKey generates an in-memory Ed25519 key for development
+func newEphemeralKey() (*signingKey, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate JWT key: %w", err)
+	}
+	return &signingKey{
+		id:      "dev-" + base64.RawURLEncoding.EncodeToString(public[:6]),
+		method:  jwt.SigningMethodEdDSA,
+		private: private,
+		public:  public,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package external
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
+)
+
+// outboxMailer implements the shared.Mailer interface for local development.
+// Messages are logged and, if a directory is configured, written there as .eml files.
+type outboxMailer struct {
+	dir string
+}
+
+// NewOutboxMailer creates a mailer that delivers to a local outbox directory
+func NewOutboxMailer(dir string) shared.Mailer {
+	return &outboxMailer{dir: dir}
+}
+
+// Send writes the message to the outbox
+func (m *outboxMailer) Send(message *shared.EmailMessage) error {
+	log.Printf("Mail to %s: %s", message.To, message.Subject)
+	if m.dir == "" {
+		log.Println(message.Body)
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create outbox: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405"), uuid.NewString())
+	content := fmt.Sprintf("To: %s\r\nSubject: %s\r\nDate: %s\r\n\r\n%s\r\n",
+		message.To, message.Subject, time.Now().UTC().Format(time.RFC1123Z), message.Body)
+	if err := os.WriteFile(filepath.Join(m.dir, name), []byte(content), 0o600); err != nil {
+		return fmt.Errorf("failed to write message to outbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// OIDCConfig configures an OpenID Connect provider
+type OIDCConfig struct {
+	// IssuerURL is used for discovery at <issuer>/.well-known/openid-configuration
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the page of the client that receives the code and state
+	RedirectURL string
+	// Scopes are requested in addition to openid
+	Scopes []string
+}
+
+// oidcProvider implements the auth.OIDCProvider interface with discovery-based configuration
+type oidcProvider struct {
+	config OIDCConfig
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// NewOIDCProvider creates an OpenID Connect provider.
+// Discovery runs on first use, so an unreachable provider does not prevent startup.
+func NewOIDCProvider(config OIDCConfig) auth.OIDCProvider {
+	return &oidcProvider{config: config}
+}
+
+// AuthCodeURL returns the authorization URL with the state, nonce and S256 PKCE challenge
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	oauthConfig, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return oauthConfig.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+// Exchange redeems the code with the PKCE verifier and verifies the returned ID token
+func (p *oidcProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*auth.ExternalIdentity, error) {
+	oauthConfig, provider, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauthConfig.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: p.config.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify ID token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("ID token nonce mismatch")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		GivenName     string `json:"given_name"`
+		FamilyName    string `json:"family_name"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse ID token claims: %w", err)
+	}
+
+	identity := &auth.ExternalIdentity{
+		Subject:       idToken.Subject,
+		Email:         strings.TrimSpace(claims.Email),
+		EmailVerified: claims.EmailVerified,
+		FirstName:     claims.GivenName,
+		LastName:      claims.FamilyName,
+	}
+	if identity.FirstName == "" && identity.LastName == "" {
+		identity.FirstName, identity.LastName, _ = strings.Cut(claims.Name, " ")
+	}
+	return identity, nil
+}
+
+// discover loads the provider metadata once and builds the OAuth2 configuration from it
+func (p *oidcProvider) discover(ctx context.Context) (*oauth2.Config, *oidc.Provider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider == nil {
+		// The provider keeps the context to refresh its signing keys, so it must outlive the request
+		provider, err := oidc.NewProvider(context.WithoutCancel(ctx), p.config.IssuerURL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to discover OIDC provider %s: %w", p.config.IssuerURL, err)
+		}
+		p.provider = provider
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Endpoint:     p.provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID}, p.config.Scopes...),
+	}, p.provider, nil
+}
@@ -0,0 +1,81 @@
+package external
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+)
+
+// TWO_FACTOR_ENCRYPTION_KEY is a base64 encoded 32 byte key encrypting stored TOTP secrets
+const envSecretKey = "TWO_FACTOR_ENCRYPTION_KEY"
+
+// aesSecretCipher implements the auth.SecretCipher interface with AES-256-GCM
+type aesSecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher creates a cipher for secrets stored in the database.
+// It fails if no key is configured, unless JWT_DEV_MODE is enabled.
+func NewSecretCipher() (auth.SecretCipher, error) {
+	var key []byte
+	if encoded := os.Getenv(envSecretKey); encoded != "" {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(decoded) != 32 {
+			return nil, fmt.Errorf("%s must be a base64 encoded 32 byte key", envSecretKey)
+		}
+		key = decoded
+	} else {
+		if os.Getenv(envDevMode) != "true" {
+			return nil, fmt.Errorf("%s is required unless %s=true", envSecretKey, envDevMode)
+		}
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate secret key: %w", err)
+		}
+		log.Printf("Dev mode: encrypting 2FA secrets with an ephemeral key, enrollments will not survive a restart")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &aesSecretCipher{aead: aead}, nil
+}
+
+// Encrypt encrypts the plaintext with a random nonce and returns base64(nonce || ciphertext)
+func (c *aesSecretCipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt reverses Encrypt
+func (c *aesSecretCipher) Decrypt(ciphertext string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("ciphertext too short")
+	}
+	nonce, sealed := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
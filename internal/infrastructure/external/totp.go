@@ -0,0 +1,52 @@
+package external
+
+import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/hotp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	totpPeriod = 30
+	// totpSkew accepts codes of one period before and after the current one for clock drift
+	totpSkew = 1
+)
+
+// totpService implements the auth.TOTPProvider interface
+type totpService struct{}
+
+// NewTOTPService creates a new TOTP service compatible with common authenticator apps
+func NewTOTPService() auth.TOTPProvider {
+	return &totpService{}
+}
+
+// GenerateSecret generates a random secret and its otpauth:// URI
+func (t *totpService) GenerateSecret(issuer, accountName string) (string, string, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: accountName,
+		Period:      totpPeriod,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return key.Secret(), key.URL(), nil
+}
+
+// ValidateCode checks the code against the time steps around now and returns the matching step
+func (t *totpService) ValidateCode(secret, code string, now time.Time) (int64, bool) {
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		ok, err := hotp.ValidateCustom(code, uint64(step), secret, hotp.ValidateOpts{
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err == nil && ok {
+			return step, true
+		}
+	}
+	return 0, false
+}
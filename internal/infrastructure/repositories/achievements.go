@@ -0,0 +1,231 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/achievements"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// catalogTables are the tables storing the definitions and grants of a catalog kind
+type catalogTables struct {
+	definitions string
+	grants      string
+	idColumn    string
+	nameColumn  string
+}
+
+var catalogs = map[achievements.Kind]catalogTables{
+	achievements.KindAchievement: {
+		definitions: "achievement_definitions",
+		grants:      "user_achievements",
+		idColumn:    "achievement_id",
+		nameColumn:  "achievement_name",
+	},
+	achievements.KindBadge: {
+		definitions: "badge_definitions",
+		grants:      "user_badges",
+		idColumn:    "badge_id",
+		nameColumn:  "badge_name",
+	},
+}
+
+// metricValues select the value of a metric for every user that has one, as (user_id, value)
+var metricValues = map[achievements.Metric]string{
+	achievements.MetricLessonsCompleted: `SELECT e.student_id AS user_id, COUNT(*) AS value
+FROM lesson_completions lc
+JOIN enrollments e ON e.id = lc.enrollment_id
+GROUP BY e.student_id`,
+	achievements.MetricCoursesCompleted: `SELECT student_id AS user_id, COUNT(*) AS value
+FROM enrollments
+WHERE progress = 100
+GROUP BY student_id`,
+	achievements.MetricXP:        `SELECT user_id, xp AS value FROM user_stats`,
+	achievements.MetricLevel:     `SELECT user_id, level AS value FROM user_stats`,
+	achievements.MetricFollowers: `SELECT user_id, followers AS value FROM user_stats`,
+}
+
+// achievementsRepository implements the achievements.Repository interface
+type achievementsRepository struct {
+	db *gorm.DB
+}
+
+// NewAchievementsRepository creates a new achievements repository
+func NewAchievementsRepository(db *gorm.DB) achievements.Repository {
+	return &achievementsRepository{db: db}
+}
+
+// ListDefinitions lists the definitions of a catalog by threshold
+func (r *achievementsRepository) ListDefinitions(kind achievements.Kind) ([]achievements.Definition, error) {
+	tables, err := catalogOf(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions []achievements.Definition
+	if err := r.db.Table(tables.definitions).
+		Order("metric, threshold, name").
+		Find(&definitions).Error; err != nil {
+		return nil, err
+	}
+	for i := range definitions {
+		definitions[i].Kind = kind
+	}
+	return definitions, nil
+}
+
+// GetDefinition retrieves a definition by ID
+func (r *achievementsRepository) GetDefinition(kind achievements.Kind, id uuid.UUID) (*achievements.Definition, error) {
+	return r.findDefinition(kind, "id = ?", id)
+}
+
+// GetDefinitionByName retrieves a definition by name
+func (r *achievementsRepository) GetDefinitionByName(kind achievements.Kind, name string) (*achievements.Definition, error) {
+	return r.findDefinition(kind, "name = ?", name)
+}
+
+// CreateDefinition creates a new definition and grants it to the users already meeting it
+func (r *achievementsRepository) CreateDefinition(definition *achievements.Definition) error {
+	tables, err := catalogOf(definition.Kind)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(tables.definitions).Create(definition).Error; err != nil {
+			return err
+		}
+		return grantQualified(tx, tables, definition)
+	})
+}
+
+// UpdateDefinition updates a definition. The name copied to existing grants is renamed with it
+// and the users meeting the new criteria are granted it.
+func (r *achievementsRepository) UpdateDefinition(definition *achievements.Definition) error {
+	tables, err := catalogOf(definition.Kind)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(tables.definitions).
+			Where("id = ?", definition.ID).
+			Select("Name", "Description", "Icon", "Metric", "Threshold", "UpdatedAt").
+			Updates(definition).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(tables.grants).
+			Where(tables.idColumn+" = ?", definition.ID).
+			Update(tables.nameColumn, definition.Name).Error; err != nil {
+			return err
+		}
+		return grantQualified(tx, tables, definition)
+	})
+}
+
+// DeleteDefinition deletes a definition, its grants are removed by the foreign key
+func (r *achievementsRepository) DeleteDefinition(kind achievements.Kind, id uuid.UUID) error {
+	tables, err := catalogOf(kind)
+	if err != nil {
+		return err
+	}
+	return r.db.Table(tables.definitions).Where("id = ?", id).Delete(&achievements.Definition{}).Error
+}
+
+// GetMetrics computes every metric of a user in one query
+func (r *achievementsRepository) GetMetrics(userID uuid.UUID) (map[achievements.Metric]int, error) {
+	var row struct {
+		LessonsCompleted int
+		CoursesCompleted int
+		XP               int
+		Level            int
+		Followers        int
+	}
+	if err := r.db.Raw(`
+SELECT
+    (SELECT COUNT(*) FROM lesson_completions lc
+     JOIN enrollments e ON e.id = lc.enrollment_id
+     WHERE e.student_id = @user) AS lessons_completed,
+    (SELECT COUNT(*) FROM enrollments e
+     WHERE e.student_id = @user AND e.progress = 100) AS courses_completed,
+    COALESCE(s.xp, 0) AS xp,
+    COALESCE(s.level, 0) AS level,
+    COALESCE(s.followers, 0) AS followers
+FROM (SELECT 1) AS one
+LEFT JOIN user_stats s ON s.user_id = @user`,
+		map[string]interface{}{"user": userID}).Scan(&row).Error; err != nil {
+		return nil, err
+	}
+
+	return map[achievements.Metric]int{
+		achievements.MetricLessonsCompleted: row.LessonsCompleted,
+		achievements.MetricCoursesCompleted: row.CoursesCompleted,
+		achievements.MetricXP:               row.XP,
+		achievements.MetricLevel:            row.Level,
+		achievements.MetricFollowers:        row.Followers,
+	}, nil
+}
+
+// Grant gives a definition to a user unless the user already has it
+func (r *achievementsRepository) Grant(userID uuid.UUID, definition *achievements.Definition) error {
+	tables, err := catalogOf(definition.Kind)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(fmt.Sprintf(`
+INSERT INTO %[1]s (id, user_id, %[2]s, %[3]s)
+VALUES (@id, @user, @definition, @name)
+ON CONFLICT (user_id, %[2]s) DO NOTHING`, tables.grants, tables.idColumn, tables.nameColumn),
+		map[string]interface{}{
+			"id":         uuid.New(),
+			"user":       userID,
+			"definition": definition.ID,
+			"name":       definition.Name,
+		}).Error
+}
+
+// grantQualified gives the definition to every user whose metric reaches the threshold, in one statement
+func grantQualified(tx *gorm.DB, tables catalogTables, definition *achievements.Definition) error {
+	values, ok := metricValues[definition.Metric]
+	if !ok {
+		return fmt.Errorf("unknown metric %q", definition.Metric)
+	}
+
+	return tx.Exec(fmt.Sprintf(`
+INSERT INTO %[1]s (user_id, %[2]s, %[3]s)
+SELECT m.user_id, @definition, @name
+FROM (%[4]s) AS m
+WHERE m.value >= @threshold
+ON CONFLICT (user_id, %[2]s) DO NOTHING`, tables.grants, tables.idColumn, tables.nameColumn, values),
+		map[string]interface{}{
+			"definition": definition.ID,
+			"name":       definition.Name,
+			"threshold":  definition.Threshold,
+		}).Error
+}
+
+// findDefinition retrieves the first definition of a catalog matching the condition
+func (r *achievementsRepository) findDefinition(kind achievements.Kind, query string, args ...interface{}) (*achievements.Definition, error) {
+	tables, err := catalogOf(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition achievements.Definition
+	if err := r.db.Table(tables.definitions).Where(query, args...).First(&definition).Error; err != nil {
+		return nil, err
+	}
+	definition.Kind = kind
+	return &definition, nil
+}
+
+// catalogOf returns the tables of a catalog kind
+func catalogOf(kind achievements.Kind) (catalogTables, error) {
+	tables, ok := catalogs[kind]
+	if !ok {
+		return catalogTables{}, fmt.Errorf("unknown catalog kind %q", kind)
+	}
+	return tables, nil
+}
@@ -16,6 +16,11 @@ func NewAuthRepository(db *gorm.DB) auth.Repository {
 	return &authRepository{db: db}
 }
 
+// WithTx returns the repository bound to a transaction
+func (r *authRepository) WithTx(tx shared.Tx) auth.Repository {
+	return &authRepository{db: txDB(tx)}
+}
+
 // GetUserByEmail retrieves a user by email
 func (r *authRepository) GetUserByEmail(email string) (*shared.User, error) {
 	var u shared.User
@@ -1,11 +1,17 @@
 package repositories
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// likeEscaper escapes LIKE wildcards so user input is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 type courseRepository struct {
 	db *gorm.DB
 }
@@ -14,22 +20,46 @@ func NewCourseRepository(db *gorm.DB) courses.Repository {
 	return &courseRepository{db: db}
 }
 
-func (r *courseRepository) GetCourses() ([]courses.Course, error) {
-	var courses []courses.Course
-	if err := r.db.
-		Preload("Student").
+func (r *courseRepository) GetCourses(filter courses.CourseFilter) ([]courses.Course, int64, error) {
+	query := r.db.Model(&courses.Course{}).Where("is_archived = ?", false)
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ?", *filter.CategoryID)
+	}
+	if filter.TutorID != nil {
+		query = query.Where("tutor_id = ?", *filter.TutorID)
+	}
+	if filter.MinRating != nil {
+		query = query.Where("rating >= ?", *filter.MinRating)
+	}
+	if filter.Query != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Query) + "%"
+		query = query.Where("(title ILIKE ? OR description ILIKE ?)", pattern, pattern)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Sort column and direction are validated by the service; id keeps pages stable on ties
+	orderBy := fmt.Sprintf("%s %s, id %s", filter.SortBy, filter.SortOrder, filter.SortOrder)
+
+	var result []courses.Course
+	if err := query.
 		Preload("Tutor").
 		Preload("Category").
-		Find(&courses).Error; err != nil {
-		return nil, err
+		Order(orderBy).
+		Limit(filter.Limit).
+		Offset((filter.Page - 1) * filter.Limit).
+		Find(&result).Error; err != nil {
+		return nil, 0, err
 	}
-	return courses, nil
+	return result, total, nil
 }
 
 func (r *courseRepository) GetCourseByID(id uuid.UUID) (*courses.Course, error) {
 	var course courses.Course
 	if err := r.db.
-		Preload("Student").
 		Preload("Tutor").
 		Preload("Category").
 		Where("id = ?", id).
@@ -38,3 +68,25 @@ func (r *courseRepository) GetCourseByID(id uuid.UUID) (*courses.Course, error)
 	}
 	return &course, nil
 }
+
+func (r *courseRepository) CreateCourse(course *courses.Course) error {
+	return r.db.Omit("Tutor", "Category").Create(course).Error
+}
+
+func (r *courseRepository) UpdateCourse(course *courses.Course) error {
+	return r.db.Model(course).
+		Select("Title", "Description", "Duration", "CategoryID", "IsArchived", "UpdatedAt").
+		Updates(course).Error
+}
+
+func (r *courseRepository) DeleteCourse(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&courses.Course{}).Error
+}
+
+func (r *courseRepository) CategoryExists(categoryID uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.db.Model(&courses.Category{}).Where("id = ?", categoryID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type enrollmentRepository struct {
+	db *gorm.DB
+}
+
+func NewEnrollmentRepository(db *gorm.DB) enrollments.Repository {
+	return &enrollmentRepository{db: db}
+}
+
+func (r *enrollmentRepository) GetEnrollment(courseID, studentID uuid.UUID) (*enrollments.Enrollment, error) {
+	var enrollment enrollments.Enrollment
+	if err := r.db.
+		Where("course_id = ? AND student_id = ?", courseID, studentID).
+		First(&enrollment).Error; err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+func (r *enrollmentRepository) GetStudentEnrollments(studentID uuid.UUID) ([]enrollments.Enrollment, error) {
+	var studentEnrollments []enrollments.Enrollment
+	if err := r.db.
+		Where("student_id = ?", studentID).
+		Order("created_at DESC").
+		Find(&studentEnrollments).Error; err != nil {
+		return nil, err
+	}
+	return studentEnrollments, nil
+}
+
+func (r *enrollmentRepository) CreateEnrollment(enrollment *enrollments.Enrollment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// The unique index on (course_id, student_id) settles concurrent enrollments
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(enrollment)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return enrollments.ErrEnrollmentExists
+		}
+		if err := tx.Model(&courses.Course{}).
+			Where("id = ?", enrollment.CourseID).
+			UpdateColumn("students_count", gorm.Expr("students_count + 1")).Error; err != nil {
+			return err
+		}
+
+		// A new enrollment starts at the first published lesson of the course
+		if err := recalculateProgress(tx, "e.id = ?", enrollment.ID); err != nil {
+			return err
+		}
+		return tx.Where("id = ?", enrollment.ID).First(enrollment).Error
+	})
+}
+
+func (r *enrollmentRepository) DeleteEnrollment(enrollment *enrollments.Enrollment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", enrollment.ID).Delete(&enrollments.Enrollment{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return tx.Model(&courses.Course{}).
+			Where("id = ? AND students_count > 0", enrollment.CourseID).
+			UpdateColumn("students_count", gorm.Expr("students_count - 1")).Error
+	})
+}
+
+func (r *enrollmentRepository) IsTutorOfStudent(tutorID, studentID uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.db.Model(&enrollments.Enrollment{}).
+		Joins("JOIN courses ON courses.id = enrollments.course_id").
+		Where("courses.tutor_id = ? AND enrollments.student_id = ?", tutorID, studentID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
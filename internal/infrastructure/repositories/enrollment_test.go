@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/lessons"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testDB opens the migrated database named by TEST_DB_DSN inside a transaction
+// that is rolled back when the test ends
+func testDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DB_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DB_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("failed to connect to the test database: %v", err)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		t.Fatalf("failed to begin transaction: %v", tx.Error)
+	}
+	t.Cleanup(func() { tx.Rollback() })
+	return tx
+}
+
+// createTestUser stores a user with the role
+func createTestUser(t *testing.T, db *gorm.DB, role shared.Role) *shared.User {
+	t.Helper()
+
+	u := &shared.User{
+		ID:       uuid.New(),
+		Email:    uuid.NewString() + "@example.com",
+		Password: "hash",
+		Role:     role,
+		Location: "test",
+	}
+	if err := db.Create(u).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return u
+}
+
+// createTestLesson stores a lesson of the course at the position
+func createTestLesson(t *testing.T, db *gorm.DB, courseID uuid.UUID, position int, status string) *lessons.Lesson {
+	t.Helper()
+
+	now := time.Now()
+	lesson := &lessons.Lesson{
+		ID:          uuid.New(),
+		CourseID:    courseID,
+		Title:       "Lesson",
+		Description: "Description",
+		VideoURL:    "https://example.com/video",
+		Duration:    "10m",
+		Position:    position,
+		Status:      status,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := db.Create(lesson).Error; err != nil {
+		t.Fatalf("failed to create lesson: %v", err)
+	}
+	return lesson
+}
+
+func TestCreateEnrollmentStartsAtFirstPublishedLesson(t *testing.T) {
+	db := testDB(t)
+
+	tutor := createTestUser(t, db, shared.RoleTutor)
+	student := createTestUser(t, db, shared.RoleStudent)
+
+	category := &courses.Category{ID: uuid.New(), Name: "Category " + uuid.NewString()}
+	if err := db.Create(category).Error; err != nil {
+		t.Fatalf("failed to create category: %v", err)
+	}
+	course := &courses.Course{
+		ID:          uuid.New(),
+		TutorID:     tutor.ID,
+		Title:       "Course",
+		Description: "Description",
+		Duration:    "1h",
+		CategoryID:  category.ID,
+	}
+	if err := db.Create(course).Error; err != nil {
+		t.Fatalf("failed to create course: %v", err)
+	}
+
+	createTestLesson(t, db, course.ID, 1, lessons.StatusDraft)
+	firstPublished := createTestLesson(t, db, course.ID, 2, lessons.StatusPublished)
+	createTestLesson(t, db, course.ID, 3, lessons.StatusPublished)
+
+	repo := NewEnrollmentRepository(db)
+	enrollment := &enrollments.Enrollment{
+		ID:        uuid.New(),
+		CourseID:  course.ID,
+		StudentID: student.ID,
+	}
+	if err := repo.CreateEnrollment(enrollment); err != nil {
+		t.Fatalf("CreateEnrollment() error = %v", err)
+	}
+
+	stored, err := repo.GetEnrollment(course.ID, student.ID)
+	if err != nil {
+		t.Fatalf("GetEnrollment() error = %v", err)
+	}
+	for name, got := range map[string]*enrollments.Enrollment{"returned": enrollment, "stored": stored} {
+		if got.NextLessonID == nil || *got.NextLessonID != firstPublished.ID {
+			t.Errorf("%s next lesson = %v, want %s", name, got.NextLessonID, firstPublished.ID)
+		}
+		if got.Progress != 0 || got.CompletedLessons != 0 {
+			t.Errorf("%s progress = %d, completed lessons = %d, want 0 and 0", name, got.Progress, got.CompletedLessons)
+		}
+	}
+}
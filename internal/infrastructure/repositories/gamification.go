@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/gamification"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// gamificationRepository implements the gamification.Repository interface
+type gamificationRepository struct {
+	db *gorm.DB
+}
+
+// NewGamificationRepository creates a new gamification repository
+func NewGamificationRepository(db *gorm.DB) gamification.Repository {
+	return &gamificationRepository{db: db}
+}
+
+// WithTx returns the repository bound to a transaction
+func (r *gamificationRepository) WithTx(tx shared.Tx) gamification.Repository {
+	return &gamificationRepository{db: txDB(tx)}
+}
+
+// GetStatsForUpdate loads and locks the stats of a user
+func (r *gamificationRepository) GetStatsForUpdate(userID uuid.UUID) (*user.UserStats, error) {
+	var stats user.UserStats
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userID).
+		First(&stats).Error; err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// AddEntry records a ledger entry unless its event was already rewarded
+func (r *gamificationRepository) AddEntry(entry *gamification.LedgerEntry) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "event_type"}, {Name: "subject_id"}},
+		DoNothing: true,
+	}).Create(entry)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// SumXP returns the total of the ledger entries of a user
+func (r *gamificationRepository) SumXP(userID uuid.UUID) (int, error) {
+	var total int
+	err := r.db.Model(&gamification.LedgerEntry{}).
+		Where("user_id = ?", userID).
+		Select("COALESCE(SUM(points), 0)").
+		Scan(&total).Error
+	return total, err
+}
+
+// UpdateProgress sets the XP, level and next level threshold of a user
+func (r *gamificationRepository) UpdateProgress(userID uuid.UUID, xp, level, nextLevelXP int) error {
+	return r.db.Model(&user.UserStats{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"xp":            xp,
+			"level":         level,
+			"next_level_xp": nextLevelXP,
+		}).Error
+}
+
+// GetLedger lists the entries of a user newest first
+func (r *gamificationRepository) GetLedger(userID uuid.UUID, limit, offset int) ([]gamification.LedgerEntry, int64, error) {
+	query := r.db.Model(&gamification.LedgerEntry{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []gamification.LedgerEntry
+	if err := query.Order("created_at DESC, id").
+		Limit(limit).
+		Offset(offset).
+		Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
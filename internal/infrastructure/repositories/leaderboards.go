@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/leaderboards"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// leaderboardsRepository implements the leaderboards.Repository interface
+type leaderboardsRepository struct {
+	db *gorm.DB
+}
+
+// NewLeaderboardsRepository creates a new leaderboards repository
+func NewLeaderboardsRepository(db *gorm.DB) leaderboards.Repository {
+	return &leaderboardsRepository{db: db}
+}
+
+// leaderboardRow is a ranked entry with its position in the leaderboard and the number of ranked users
+type leaderboardRow struct {
+	leaderboards.Entry
+	Position int64
+	Total    int64
+}
+
+// GetRanking ranks the users in one query and returns the rows of the page and of the viewer.
+// The last ranked row is always selected too, so the number of ranked users is known on
+// pages past the end.
+func (r *leaderboardsRepository) GetRanking(filter leaderboards.Filter, viewerID uuid.UUID, limit, offset int) (*leaderboards.Ranking, error) {
+	scores, args := leaderboardScores(filter)
+	args["user"] = viewerID
+	args["first"] = offset + 1
+	args["last"] = offset + limit
+
+	var rows []leaderboardRow
+	if err := r.db.Raw(`
+WITH scores AS (`+scores+`),
+ranked AS (
+    SELECT user_id, xp,
+        RANK() OVER (ORDER BY xp DESC) AS rank,
+        ROW_NUMBER() OVER (ORDER BY xp DESC, user_id) AS position,
+        COUNT(*) OVER () AS total
+    FROM scores
+)
+SELECT r.rank, r.position, r.total, r.user_id, r.xp,
+    COALESCE(ui.first_name, '') AS first_name,
+    COALESCE(ui.last_name, '') AS last_name,
+    COALESCE(ui.avatar, '') AS avatar,
+    COALESCE(s.level, 1) AS level
+FROM ranked r
+LEFT JOIN user_infos ui ON ui.user_id = r.user_id
+LEFT JOIN user_stats s ON s.user_id = r.user_id
+WHERE r.position BETWEEN @first AND @last OR r.user_id = @user OR r.position = r.total
+ORDER BY r.position`, args).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	ranking := &leaderboards.Ranking{Entries: []leaderboards.Entry{}}
+	for i := range rows {
+		row := &rows[i]
+		ranking.Total = row.Total
+		if row.Position >= int64(offset+1) && row.Position <= int64(offset+limit) {
+			ranking.Entries = append(ranking.Entries, row.Entry)
+		}
+		if row.UserID == viewerID {
+			me := row.Entry
+			ranking.Me = &me
+		}
+	}
+	return ranking, nil
+}
+
+// CategoryExists reports whether a course category exists
+func (r *leaderboardsRepository) CategoryExists(categoryID uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.db.Model(&courses.Category{}).Where("id = ?", categoryID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// leaderboardScores returns the query of the XP per user matching the filter.
+// All time XP comes from user_stats, other filters sum the XP ledger.
+func leaderboardScores(filter leaderboards.Filter) (string, map[string]interface{}) {
+	args := map[string]interface{}{}
+	if filter.Since == nil && filter.CategoryID == nil {
+		return "SELECT user_id, xp FROM user_stats WHERE xp > 0", args
+	}
+
+	query := "SELECT x.user_id, SUM(x.points) AS xp FROM xp_ledger x"
+	where := "TRUE"
+	if filter.CategoryID != nil {
+		// Lesson XP belongs to the course of the lesson, course XP to the course itself
+		query += `
+LEFT JOIN lessons l ON x.event_type = @lesson_completed AND l.id = x.subject_id
+JOIN courses c ON c.id = CASE WHEN x.event_type = @course_completed THEN x.subject_id ELSE l.course_id END`
+		where += " AND c.category_id = @category"
+		args["lesson_completed"] = shared.EventLessonCompleted
+		args["course_completed"] = shared.EventCourseCompleted
+		args["category"] = *filter.CategoryID
+	}
+	if filter.Since != nil {
+		where += " AND x.created_at >= @since"
+		args["since"] = *filter.Since
+	}
+	return query + "\nWHERE " + where + "\nGROUP BY x.user_id HAVING SUM(x.points) > 0", args
+}
@@ -1,8 +1,14 @@
 package repositories
 
 import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/courses"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/enrollments"
 	"github.com/IbadT/tutor_app_back.git/internal/domain/lessons"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type LessonsRepository struct {
@@ -13,14 +19,225 @@ func NewLessonsRepository(db *gorm.DB) *LessonsRepository {
 	return &LessonsRepository{db: db}
 }
 
-func (r *LessonsRepository) GetLessons() ([]lessons.Lesson, error) {
+func (r *LessonsRepository) GetLessons(visibility lessons.LessonVisibility) ([]lessons.Lesson, error) {
+	query := r.db.Model(&lessons.Lesson{})
+	if !visibility.AllStatuses {
+		if visibility.OwnerID != nil {
+			ownedCourseIDs := r.db.Model(&courses.Course{}).
+				Select("id").
+				Where("tutor_id = ?", *visibility.OwnerID)
+			query = query.Where("status = ? OR course_id IN (?)", lessons.StatusPublished, ownedCourseIDs)
+		} else {
+			query = query.Where("status = ?", lessons.StatusPublished)
+		}
+	}
+
 	var lessons []lessons.Lesson
-	if err := r.db.Find(&lessons).Error; err != nil {
+	if err := query.Order("course_id, position, id").Find(&lessons).Error; err != nil {
 		return nil, err
 	}
 	return lessons, nil
 }
 
+func (r *LessonsRepository) GetLessonByID(id uuid.UUID) (*lessons.Lesson, error) {
+	var lesson lessons.Lesson
+	if err := r.db.Where("id = ?", id).First(&lesson).Error; err != nil {
+		return nil, err
+	}
+	return &lesson, nil
+}
+
+func (r *LessonsRepository) GetCourseLessons(courseID uuid.UUID, publishedOnly bool) ([]lessons.Lesson, error) {
+	query := r.db.Where("course_id = ?", courseID)
+	if publishedOnly {
+		query = query.Where("status = ?", lessons.StatusPublished)
+	}
+
+	var courseLessons []lessons.Lesson
+	if err := query.Order("position, id").Find(&courseLessons).Error; err != nil {
+		return nil, err
+	}
+	return courseLessons, nil
+}
+
 func (r *LessonsRepository) CreateLesson(lesson *lessons.Lesson) error {
-	return r.db.Create(lesson).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCourse(tx, lesson.CourseID); err != nil {
+			return err
+		}
+
+		var lastPosition int
+		if err := tx.Model(&lessons.Lesson{}).
+			Where("course_id = ?", lesson.CourseID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&lastPosition).Error; err != nil {
+			return err
+		}
+		lesson.Position = lastPosition + 1
+
+		if err := tx.Create(lesson).Error; err != nil {
+			return err
+		}
+		return syncTotalLessons(tx, lesson.CourseID)
+	})
+}
+
+func (r *LessonsRepository) UpdateLesson(lesson *lessons.Lesson) error {
+	return r.db.Model(lesson).
+		Select("Title", "Description", "VideoURL", "Duration", "UpdatedAt").
+		Updates(lesson).Error
+}
+
+func (r *LessonsRepository) UpdateLessonStatus(lesson *lessons.Lesson) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCourse(tx, lesson.CourseID); err != nil {
+			return err
+		}
+		if err := tx.Model(lesson).Select("Status", "UpdatedAt").Updates(lesson).Error; err != nil {
+			return err
+		}
+		if err := syncTotalLessons(tx, lesson.CourseID); err != nil {
+			return err
+		}
+		return recalculateProgress(tx, "e.course_id = ?", lesson.CourseID)
+	})
+}
+
+func (r *LessonsRepository) DeleteLesson(lesson *lessons.Lesson) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCourse(tx, lesson.CourseID); err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", lesson.ID).Delete(&lessons.Lesson{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&lessons.Lesson{}).
+			Where("course_id = ? AND position > ?", lesson.CourseID, lesson.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error; err != nil {
+			return err
+		}
+		if err := syncTotalLessons(tx, lesson.CourseID); err != nil {
+			return err
+		}
+		return recalculateProgress(tx, "e.course_id = ?", lesson.CourseID)
+	})
+}
+
+func (r *LessonsRepository) ReorderLessons(courseID uuid.UUID, lessonIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCourse(tx, courseID); err != nil {
+			return err
+		}
+
+		var lessonCount int64
+		if err := tx.Model(&lessons.Lesson{}).
+			Where("course_id = ?", courseID).
+			Count(&lessonCount).Error; err != nil {
+			return err
+		}
+		if lessonCount != int64(len(lessonIDs)) {
+			return lessons.ErrLessonOrderMismatch
+		}
+
+		for i, id := range lessonIDs {
+			result := tx.Model(&lessons.Lesson{}).
+				Where("id = ? AND course_id = ?", id, courseID).
+				UpdateColumn("position", i+1)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return lessons.ErrLessonOrderMismatch
+			}
+		}
+
+		// The next lesson of every enrollment follows the new order
+		return recalculateProgress(tx, "e.course_id = ?", courseID)
+	})
+}
+
+func (r *LessonsRepository) CompleteLesson(completion *lessons.LessonCompletion) (*enrollments.Enrollment, error) {
+	var enrollment enrollments.Enrollment
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the enrollment so concurrent completions are recalculated one after another
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", completion.EnrollmentID).
+			First(&enrollment).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(completion).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("enrollment_id = ? AND lesson_id = ?", completion.EnrollmentID, completion.LessonID).
+			First(completion).Error; err != nil {
+			return err
+		}
+
+		if err := recalculateProgress(tx, "e.id = ?", enrollment.ID); err != nil {
+			return err
+		}
+		return tx.Where("id = ?", enrollment.ID).First(&enrollment).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+// lockCourse locks the course row so lesson positions and counters of the course change one writer at a time
+func lockCourse(tx *gorm.DB, courseID uuid.UUID) error {
+	var course courses.Course
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", courseID).
+		First(&course).Error
+}
+
+// syncTotalLessons recalculates the cached count of published lessons of a course
+func syncTotalLessons(tx *gorm.DB, courseID uuid.UUID) error {
+	lessonCount := tx.Model(&lessons.Lesson{}).
+		Select("COUNT(*)").
+		Where("course_id = ? AND status = ?", courseID, lessons.StatusPublished)
+	return tx.Model(&courses.Course{}).
+		Where("id = ?", courseID).
+		UpdateColumn("total_lessons", lessonCount).Error
+}
+
+// recalculateProgress recomputes completed lessons, progress and the next lesson of the
+// enrollments matching condition, which refers to the enrollments table as "e".
+// Only published lessons are counted.
+func recalculateProgress(tx *gorm.DB, condition string, args ...interface{}) error {
+	query := `
+UPDATE enrollments SET
+    completed_lessons = stats.completed,
+    progress = CASE WHEN stats.total > 0 THEN stats.completed * 100 / stats.total ELSE 0 END,
+    next_lesson_id = (
+        SELECT l.id FROM lessons l
+        WHERE l.course_id = enrollments.course_id AND l.status = @published
+          AND NOT EXISTS (
+              SELECT 1 FROM lesson_completions lc
+              WHERE lc.enrollment_id = enrollments.id AND lc.lesson_id = l.id
+          )
+        ORDER BY l.position, l.id
+        LIMIT 1
+    ),
+    updated_at = @now
+FROM (
+    SELECT e.id,
+        (SELECT COUNT(*) FROM lessons l
+         WHERE l.course_id = e.course_id AND l.status = @published) AS total,
+        (SELECT COUNT(*) FROM lesson_completions lc
+         JOIN lessons l ON l.id = lc.lesson_id
+         WHERE lc.enrollment_id = e.id AND l.status = @published) AS completed
+    FROM enrollments e
+    WHERE @condition
+) AS stats
+WHERE enrollments.id = stats.id`
+
+	return tx.Exec(query, map[string]interface{}{
+		"published": lessons.StatusPublished,
+		"now":       time.Now(),
+		"condition": gorm.Expr(condition, args...),
+	}).Error
 }
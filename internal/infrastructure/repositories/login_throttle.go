@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"gorm.io/gorm"
+)
+
+// loginThrottleRepository implements the auth.LoginThrottleRepository interface
+type loginThrottleRepository struct {
+	db *gorm.DB
+}
+
+// NewLoginThrottleRepository creates a new failed login tracking repository
+func NewLoginThrottleRepository(db *gorm.DB) auth.LoginThrottleRepository {
+	return &loginThrottleRepository{db: db}
+}
+
+// GetLoginThrottle retrieves the failed login counter of an account or a client IP
+func (r *loginThrottleRepository) GetLoginThrottle(scope, key string) (*auth.LoginThrottle, error) {
+	var throttle auth.LoginThrottle
+	if err := r.db.Where("scope = ? AND key = ?", scope, key).First(&throttle).Error; err != nil {
+		return nil, err
+	}
+	return &throttle, nil
+}
+
+// RecordLoginFailure counts a failure with a single upsert so concurrent attempts are not lost
+func (r *loginThrottleRepository) RecordLoginFailure(scope, key string, window time.Duration) (*auth.LoginThrottle, error) {
+	query := `
+INSERT INTO login_throttles (scope, key, failures, last_failure_at)
+VALUES (@scope, @key, 1, @now)
+ON CONFLICT (scope, key) DO UPDATE SET
+    failures = CASE
+        WHEN GREATEST(login_throttles.last_failure_at, COALESCE(login_throttles.locked_until, login_throttles.last_failure_at)) < @window_start
+        THEN 1
+        ELSE login_throttles.failures + 1
+    END,
+    last_failure_at = @now
+RETURNING scope, key, failures, last_failure_at, locked_until`
+
+	now := time.Now()
+	var throttle auth.LoginThrottle
+	if err := r.db.Raw(query, map[string]interface{}{
+		"scope":        scope,
+		"key":          key,
+		"now":          now,
+		"window_start": now.Add(-window),
+	}).Scan(&throttle).Error; err != nil {
+		return nil, err
+	}
+	return &throttle, nil
+}
+
+// LockLogin locks the counter and records the lockout event
+func (r *loginThrottleRepository) LockLogin(scope, key string, lockedUntil time.Time, event *auth.LockoutEvent) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&auth.LoginThrottle{}).
+			Where("scope = ? AND key = ?", scope, key).
+			Update("locked_until", lockedUntil).Error; err != nil {
+			return err
+		}
+		return tx.Create(event).Error
+	})
+}
+
+// ResetLoginFailures forgets the failures of an account or a client IP
+func (r *loginThrottleRepository) ResetLoginFailures(scope, key string) error {
+	return r.db.Where("scope = ? AND key = ?", scope, key).Delete(&auth.LoginThrottle{}).Error
+}
+
+// GetLockoutEvents returns a page of lockout events, newest first, and the total count
+func (r *loginThrottleRepository) GetLockoutEvents(limit, offset int) ([]auth.LockoutEvent, int64, error) {
+	var total int64
+	if err := r.db.Model(&auth.LockoutEvent{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var events []auth.LockoutEvent
+	if err := r.db.Order("created_at DESC, id").
+		Limit(limit).
+		Offset(offset).
+		Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// oidcRepository implements the auth.OIDCRepository interface
+type oidcRepository struct {
+	db *gorm.DB
+}
+
+// NewOIDCRepository creates a new external identity repository
+func NewOIDCRepository(db *gorm.DB) auth.OIDCRepository {
+	return &oidcRepository{db: db}
+}
+
+// WithTx returns the repository bound to a transaction
+func (r *oidcRepository) WithTx(tx shared.Tx) auth.OIDCRepository {
+	return &oidcRepository{db: txDB(tx)}
+}
+
+// CreateOIDCState stores a pending authorization request
+func (r *oidcRepository) CreateOIDCState(state *auth.OIDCState) error {
+	return r.db.Create(state).Error
+}
+
+// ConsumeOIDCState deletes the state and returns it if it has not expired.
+// Expired states are cleaned up on the way.
+func (r *oidcRepository) ConsumeOIDCState(stateHash string) (*auth.OIDCState, error) {
+	var states []auth.OIDCState
+	if err := r.db.Clauses(clause.Returning{}).
+		Where("state_hash = ? OR expires_at < ?", stateHash, time.Now()).
+		Delete(&states).Error; err != nil {
+		return nil, err
+	}
+	for i := range states {
+		if states[i].StateHash == stateHash && states[i].ExpiresAt.After(time.Now()) {
+			return &states[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// GetIdentity retrieves the link of an external identity
+func (r *oidcRepository) GetIdentity(provider, subject string) (*auth.UserIdentity, error) {
+	var identity auth.UserIdentity
+	if err := r.db.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error; err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// CreateIdentity links an external identity to a user
+func (r *oidcRepository) CreateIdentity(identity *auth.UserIdentity) error {
+	return r.db.Create(identity).Error
+}
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// refreshTokenRepository implements the auth.RefreshTokenRepository interface
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository
+func NewRefreshTokenRepository(db *gorm.DB) auth.RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+// CreateRefreshToken stores a newly issued refresh token
+func (r *refreshTokenRepository) CreateRefreshToken(token *auth.RefreshToken) error {
+	return r.db.Create(token).Error
+}
+
+// GetRefreshToken retrieves a refresh token by its jti
+func (r *refreshTokenRepository) GetRefreshToken(id uuid.UUID) (*auth.RefreshToken, error) {
+	var token auth.RefreshToken
+	if err := r.db.Where("id = ?", id).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RotateRefreshToken revokes the old token and stores its replacement
+func (r *refreshTokenRepository) RotateRefreshToken(oldID uuid.UUID, next *auth.RefreshToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(next).Error; err != nil {
+			return err
+		}
+
+		// Only one request may rotate a token, the loser sees no affected rows
+		result := tx.Model(&auth.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", oldID).
+			Updates(map[string]interface{}{
+				"revoked_at":  time.Now(),
+				"replaced_by": next.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return auth.ErrRefreshTokenRevoked
+		}
+		return nil
+	})
+}
+
+// RevokeFamily revokes every active token issued for the same login, and the login's session
+func (r *refreshTokenRepository) RevokeFamily(familyID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return revokeSessions(tx, "id = ?", familyID)
+	})
+}
+
+// RevokeUserTokens revokes every active token and session of the user
+func (r *refreshTokenRepository) RevokeUserTokens(userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return revokeSessions(tx, "user_id = ?", userID)
+	})
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// sessionRepository implements the auth.SessionRepository interface
+type sessionRepository struct {
+	db *gorm.DB
+}
+
+// NewSessionRepository creates a new session repository
+func NewSessionRepository(db *gorm.DB) auth.SessionRepository {
+	return &sessionRepository{db: db}
+}
+
+// CreateSession stores a new login session
+func (r *sessionRepository) CreateSession(session *auth.Session) error {
+	return r.db.Create(session).Error
+}
+
+// GetSession retrieves a session by ID
+func (r *sessionRepository) GetSession(id uuid.UUID) (*auth.Session, error) {
+	var session auth.Session
+	if err := r.db.Where("id = ?", id).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// GetUserSessions returns the sessions that still have a usable refresh token
+func (r *sessionRepository) GetUserSessions(userID uuid.UUID) ([]auth.Session, error) {
+	activeFamilies := r.db.Model(&auth.RefreshToken{}).
+		Select("family_id").
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now())
+
+	var sessions []auth.Session
+	if err := r.db.
+		Where("user_id = ? AND revoked_at IS NULL AND id IN (?)", userID, activeFamilies).
+		Order("last_used_at DESC, id").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// TouchSession updates the last used time at most once per auth.SessionTouchInterval
+func (r *sessionRepository) TouchSession(id uuid.UUID, usedAt time.Time) error {
+	return r.db.Model(&auth.Session{}).
+		Where("id = ? AND last_used_at < ?", id, usedAt.Add(-auth.SessionTouchInterval)).
+		UpdateColumn("last_used_at", usedAt).Error
+}
+
+// RevokeSession revokes an active session of the user together with its refresh tokens
+func (r *sessionRepository) RevokeSession(userID, sessionID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var session auth.Session
+		if err := tx.Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+			First(&session).Error; err != nil {
+			return err
+		}
+		return revokeSessions(tx, "id = ?", session.ID)
+	})
+}
+
+// revokeSessions revokes the sessions matching condition and the refresh tokens of their families
+func revokeSessions(tx *gorm.DB, condition string, args ...interface{}) error {
+	now := time.Now()
+	sessionIDs := tx.Model(&auth.Session{}).Select("id").Where(condition, args...)
+	if err := tx.Model(&auth.RefreshToken{}).
+		Where("family_id IN (?) AND revoked_at IS NULL", sessionIDs).
+		Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	return tx.Model(&auth.Session{}).
+		Where(condition, args...).
+		Where("revoked_at IS NULL").
+		Update("revoked_at", now).Error
+}
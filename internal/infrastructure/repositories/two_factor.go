@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// twoFactorRepository implements the auth.TwoFactorRepository interface
+type twoFactorRepository struct {
+	db *gorm.DB
+}
+
+// NewTwoFactorRepository creates a new two-factor authentication repository
+func NewTwoFactorRepository(db *gorm.DB) auth.TwoFactorRepository {
+	return &twoFactorRepository{db: db}
+}
+
+// GetTwoFactor retrieves the 2FA settings of a user
+func (r *twoFactorRepository) GetTwoFactor(userID uuid.UUID) (*auth.TwoFactor, error) {
+	var twoFactor auth.TwoFactor
+	if err := r.db.Where("user_id = ?", userID).First(&twoFactor).Error; err != nil {
+		return nil, err
+	}
+	return &twoFactor, nil
+}
+
+// SaveTwoFactor creates a pending enrollment or replaces the secret of a pending one
+func (r *twoFactorRepository) SaveTwoFactor(twoFactor *auth.TwoFactor) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "confirmed_at", "last_used_step", "updated_at"}),
+		Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "user_two_factor.confirmed_at IS NULL"}}},
+	}).Create(twoFactor).Error
+}
+
+// ConfirmTwoFactor enables 2FA and replaces the recovery codes
+func (r *twoFactorRepository) ConfirmTwoFactor(userID uuid.UUID, step int64, codes []auth.RecoveryCode) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&auth.TwoFactor{}).
+			Where("user_id = ?", userID).
+			Updates(map[string]interface{}{
+				"confirmed_at":   time.Now(),
+				"last_used_step": step,
+				"updated_at":     time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&auth.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+// UseTOTPStep records the time step of an accepted code only if it is newer than the last one
+func (r *twoFactorRepository) UseTOTPStep(userID uuid.UUID, step int64) error {
+	result := r.db.Model(&auth.TwoFactor{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		UpdateColumn("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return auth.ErrTwoFactorCodeUsed
+	}
+	return nil
+}
+
+// UseRecoveryCode marks an unused recovery code of the user as used
+func (r *twoFactorRepository) UseRecoveryCode(userID uuid.UUID, codeHash string) error {
+	result := r.db.Model(&auth.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return auth.ErrRecoveryCodeInvalid
+	}
+	return nil
+}
+
+// DeleteTwoFactor removes the 2FA settings and recovery codes of a user
+func (r *twoFactorRepository) DeleteTwoFactor(userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&auth.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&auth.TwoFactor{}).Error
+	})
+}
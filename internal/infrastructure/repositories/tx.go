@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// txDB returns the gorm transaction behind a shared.Tx opened by database.NewUnitOfWork
+func txDB(tx shared.Tx) *gorm.DB {
+	return tx.(*gorm.DB)
+}
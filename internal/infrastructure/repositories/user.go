@@ -5,6 +5,7 @@ import (
 	"github.com/IbadT/tutor_app_back.git/internal/domain/user"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // userRepository implements the user.Repository interface
@@ -17,6 +18,11 @@ func NewUserRepository(db *gorm.DB) user.Repository {
 	return &userRepository{db: db}
 }
 
+// WithTx returns the repository bound to a transaction
+func (r *userRepository) WithTx(tx shared.Tx) user.Repository {
+	return &userRepository{db: txDB(tx)}
+}
+
 // GetByID retrieves a user by ID
 func (r *userRepository) GetByID(id uuid.UUID) (*shared.User, error) {
 	var u shared.User
@@ -47,9 +53,30 @@ func (r *userRepository) Update(u *shared.User) error {
 	return r.db.Save(u).Error
 }
 
-// Delete deletes a user by ID
+// Delete deletes a user by ID. Follows of the user are removed with it, so the
+// counters of the users on the other side are recalculated.
 func (r *userRepository) Delete(id uuid.UUID) error {
-	return r.db.Where("id = ?", id).Delete(&shared.User{}).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var related []uuid.UUID
+		if err := tx.Raw(`
+SELECT followee_id FROM follows WHERE follower_id = @id
+UNION
+SELECT follower_id FROM follows WHERE followee_id = @id`,
+			map[string]interface{}{"id": id}).Scan(&related).Error; err != nil {
+			return err
+		}
+		if err := lockUserStats(tx, related...); err != nil {
+			return err
+		}
+
+		if err := tx.Where("follower_id = ? OR followee_id = ?", id, id).Delete(&user.Follow{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", id).Delete(&shared.User{}).Error; err != nil {
+			return err
+		}
+		return syncFollowCounts(tx, related...)
+	})
 }
 
 // GetUserInfo retrieves user information by user ID
@@ -100,7 +127,12 @@ func (r *userRepository) UpdateUserStats(stats *user.UserStats) error {
 // GetUserAchievements retrieves user achievements
 func (r *userRepository) GetUserAchievements(userID uuid.UUID) ([]user.UserAchievements, error) {
 	var achievements []user.UserAchievements
-	err := r.db.Where("user_id = ?", userID).Find(&achievements).Error
+	err := r.db.Model(&user.UserAchievements{}).
+		Select("user_achievements.*, COALESCE(d.description, '') AS description, COALESCE(d.icon, '') AS icon").
+		Joins("LEFT JOIN achievement_definitions d ON d.id = user_achievements.achievement_id").
+		Where("user_achievements.user_id = ?", userID).
+		Order("user_achievements.created_at, user_achievements.id").
+		Find(&achievements).Error
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +152,12 @@ func (r *userRepository) DeleteUserAchievement(id uuid.UUID) error {
 // GetUserBadges retrieves user badges
 func (r *userRepository) GetUserBadges(userID uuid.UUID) ([]user.UserBadges, error) {
 	var badges []user.UserBadges
-	err := r.db.Where("user_id = ?", userID).Find(&badges).Error
+	err := r.db.Model(&user.UserBadges{}).
+		Select("user_badges.*, COALESCE(d.description, '') AS description, COALESCE(d.icon, '') AS icon").
+		Joins("LEFT JOIN badge_definitions d ON d.id = user_badges.badge_id").
+		Where("user_badges.user_id = ?", userID).
+		Order("user_badges.created_at, user_badges.id").
+		Find(&badges).Error
 	if err != nil {
 		return nil, err
 	}
@@ -157,3 +194,164 @@ func (r *userRepository) UpdateStudentStatus(replacerID, studentID uuid.UUID, st
 
 	return r.db.Model(&shared.User{}).Where("id = ?", studentID).Updates(updates).Error
 }
+
+// ChangeUserRole updates the role of a user, records the change and revokes the user's sessions
+func (r *userRepository) ChangeUserRole(change *user.RoleChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var u shared.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", change.UserID).
+			First(&u).Error; err != nil {
+			return err
+		}
+		if u.Role == change.NewRole {
+			return user.ErrRoleUnchanged
+		}
+		change.OldRole = u.Role
+
+		if err := tx.Model(&shared.User{}).
+			Where("id = ?", change.UserID).
+			Update("role", change.NewRole).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(change).Error; err != nil {
+			return err
+		}
+		return revokeSessions(tx, "user_id = ?", change.UserID)
+	})
+}
+
+// GetRoleChanges lists role changes newest first
+func (r *userRepository) GetRoleChanges(userID *uuid.UUID, limit, offset int) ([]user.RoleChange, int64, error) {
+	query := r.db.Model(&user.RoleChange{})
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var changes []user.RoleChange
+	if err := query.Order("created_at DESC, id").
+		Limit(limit).
+		Offset(offset).
+		Find(&changes).Error; err != nil {
+		return nil, 0, err
+	}
+	return changes, total, nil
+}
+
+// UpdateAvatar sets the avatar URL and blob store prefix of a user
+func (r *userRepository) UpdateAvatar(userID uuid.UUID, avatarURL, avatarKey string) error {
+	result := r.db.Model(&user.UserInfo{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"avatar":     avatarURL,
+			"avatar_key": avatarKey,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Follow records a follow and recalculates the counters of both users
+func (r *userRepository) Follow(follow *user.Follow) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockUserStats(tx, follow.FollowerID, follow.FolloweeID); err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(follow)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return user.ErrAlreadyFollowing
+		}
+		return syncFollowCounts(tx, follow.FollowerID, follow.FolloweeID)
+	})
+}
+
+// Unfollow removes a follow and recalculates the counters of both users
+func (r *userRepository) Unfollow(followerID, followeeID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockUserStats(tx, followerID, followeeID); err != nil {
+			return err
+		}
+
+		result := tx.Where("follower_id = ? AND followee_id = ?", followerID, followeeID).Delete(&user.Follow{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return user.ErrNotFollowing
+		}
+		return syncFollowCounts(tx, followerID, followeeID)
+	})
+}
+
+// GetFollowers lists the users following a user, most recent first
+func (r *userRepository) GetFollowers(userID uuid.UUID, limit, offset int) ([]user.FollowUser, int64, error) {
+	return r.listFollows("followee_id", "follower_id", userID, limit, offset)
+}
+
+// GetFollowing lists the users a user follows, most recent first
+func (r *userRepository) GetFollowing(userID uuid.UUID, limit, offset int) ([]user.FollowUser, int64, error) {
+	return r.listFollows("follower_id", "followee_id", userID, limit, offset)
+}
+
+// listFollows lists the follows whose userColumn is userID with the profile of the user in otherColumn
+func (r *userRepository) listFollows(userColumn, otherColumn string, userID uuid.UUID, limit, offset int) ([]user.FollowUser, int64, error) {
+	var total int64
+	if err := r.db.Model(&user.Follow{}).
+		Where(userColumn+" = ?", userID).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	users := []user.FollowUser{}
+	if err := r.db.Table("follows AS f").
+		Select("f."+otherColumn+" AS user_id, COALESCE(ui.first_name, '') AS first_name, "+
+			"COALESCE(ui.last_name, '') AS last_name, COALESCE(ui.avatar, '') AS avatar, f.created_at AS followed_at").
+		Joins("LEFT JOIN user_infos ui ON ui.user_id = f."+otherColumn).
+		Where("f."+userColumn+" = ?", userID).
+		Order("f.created_at DESC, f." + otherColumn).
+		Limit(limit).
+		Offset(offset).
+		Scan(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// lockUserStats locks the stats rows of the users in a fixed order, so follow counters are
+// recalculated one writer at a time and concurrent follows between two users cannot deadlock
+func lockUserStats(tx *gorm.DB, userIDs ...uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	var stats []user.UserStats
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("user_id IN ?", userIDs).
+		Order("user_id").
+		Find(&stats).Error
+}
+
+// syncFollowCounts recalculates the follower and following counters of the users from the follows table
+func syncFollowCounts(tx *gorm.DB, userIDs ...uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return tx.Exec(`
+UPDATE user_stats SET
+    followers = (SELECT COUNT(*) FROM follows f WHERE f.followee_id = user_stats.user_id),
+    following = (SELECT COUNT(*) FROM follows f WHERE f.follower_id = user_stats.user_id)
+WHERE user_id IN ?`, userIDs).Error
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/IbadT/tutor_app_back.git/internal/domain/auth"
+	"github.com/IbadT/tutor_app_back.git/internal/domain/shared"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userTokenRepository implements the auth.UserTokenRepository interface
+type userTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewUserTokenRepository creates a new one-time token repository
+func NewUserTokenRepository(db *gorm.DB) auth.UserTokenRepository {
+	return &userTokenRepository{db: db}
+}
+
+// CreateUserToken stores the token and invalidates earlier unused tokens of the same purpose
+func (r *userTokenRepository) CreateUserToken(token *auth.UserToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&auth.UserToken{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", token.UserID, token.Purpose).
+			Update("used_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Create(token).Error
+	})
+}
+
+// VerifyEmail consumes an email verification token and marks its user as verified
+func (r *userTokenRepository) VerifyEmail(tokenHash string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		token, err := consumeUserToken(tx, auth.TokenPurposeEmailVerification, tokenHash)
+		if err != nil {
+			return err
+		}
+		return tx.Model(&shared.User{}).
+			Where("id = ?", token.UserID).
+			Update("is_verified", true).Error
+	})
+}
+
+// ResetPassword consumes a password reset token, sets the password and revokes the user's sessions
+func (r *userTokenRepository) ResetPassword(tokenHash, passwordHash string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		token, err := consumeUserToken(tx, auth.TokenPurposePasswordReset, tokenHash)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&shared.User{}).
+			Where("id = ?", token.UserID).
+			Update("password", passwordHash).Error; err != nil {
+			return err
+		}
+		return revokeSessions(tx, "user_id = ?", token.UserID)
+	})
+}
+
+// consumeUserToken locks a usable token and marks it as used
+func consumeUserToken(tx *gorm.DB, purpose, tokenHash string) (*auth.UserToken, error) {
+	var token auth.UserToken
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ? AND purpose = ?", tokenHash, purpose).
+		First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, auth.ErrUserTokenInvalid
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if token.UsedAt != nil || now.After(token.ExpiresAt) {
+		return nil, auth.ErrUserTokenInvalid
+	}
+
+	token.UsedAt = &now
+	if err := tx.Model(&token).Update("used_at", now).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
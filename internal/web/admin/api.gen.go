@@ -0,0 +1,1756 @@
+// Package admin provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for ChangeRoleRequestRole.
+const (
+	Admin   ChangeRoleRequestRole = "admin"
+	Student ChangeRoleRequestRole = "student"
+	Tutor   ChangeRoleRequestRole = "tutor"
+)
+
+// Defines values for LockoutEventScope.
+const (
+	Account LockoutEventScope = "account"
+	Ip      LockoutEventScope = "ip"
+)
+
+// Defines values for RewardMetric.
+const (
+	CoursesCompleted RewardMetric = "courses_completed"
+	Followers        RewardMetric = "followers"
+	LessonsCompleted RewardMetric = "lessons_completed"
+	Level            RewardMetric = "level"
+	Xp               RewardMetric = "xp"
+)
+
+// ChangeRoleRequest defines model for ChangeRoleRequest.
+type ChangeRoleRequest struct {
+	// Reason Why the role is changed, kept in the audit log
+	Reason *string               `json:"reason,omitempty"`
+	Role   ChangeRoleRequestRole `json:"role"`
+}
+
+// ChangeRoleRequestRole defines model for ChangeRoleRequest.Role.
+type ChangeRoleRequestRole string
+
+// Error defines model for Error.
+type Error struct {
+	Code    *int    `json:"code,omitempty"`
+	Details *string `json:"details,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// LockoutEvent defines model for LockoutEvent.
+type LockoutEvent struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Failures  *int                `json:"failures,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	IpAddress *string             `json:"ip_address,omitempty"`
+
+	// Key Lowercased email for account lockouts, client IP for IP lockouts
+	Key         *string             `json:"key,omitempty"`
+	LockedUntil *time.Time          `json:"locked_until,omitempty"`
+	Scope       *LockoutEventScope  `json:"scope,omitempty"`
+	UserAgent   *string             `json:"user_agent,omitempty"`
+	UserId      *openapi_types.UUID `json:"user_id"`
+}
+
+// LockoutEventScope defines model for LockoutEvent.Scope.
+type LockoutEventScope string
+
+// LockoutEventsPage defines model for LockoutEventsPage.
+type LockoutEventsPage struct {
+	Events     *[]LockoutEvent `json:"events,omitempty"`
+	Pagination *Pagination     `json:"pagination,omitempty"`
+}
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	Limit *int `json:"limit,omitempty"`
+	Page  *int `json:"page,omitempty"`
+	Total *int `json:"total,omitempty"`
+}
+
+// RewardDefinition defines model for RewardDefinition.
+type RewardDefinition struct {
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Icon        *string             `json:"icon,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	Metric      *RewardMetric       `json:"metric,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+
+	// Threshold Value of the metric the definition is granted at
+	Threshold *int       `json:"threshold,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// RewardDefinitionRequest defines model for RewardDefinitionRequest.
+type RewardDefinitionRequest struct {
+	Description *string      `json:"description,omitempty"`
+	Icon        *string      `json:"icon,omitempty"`
+	Metric      RewardMetric `json:"metric"`
+	Name        string       `json:"name"`
+	Threshold   int          `json:"threshold"`
+}
+
+// RewardMetric defines model for RewardMetric.
+type RewardMetric string
+
+// RoleChange defines model for RoleChange.
+type RoleChange struct {
+	// ChangedBy Admin who changed the role
+	ChangedBy *openapi_types.UUID `json:"changed_by,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	NewRole   *string             `json:"new_role,omitempty"`
+	OldRole   *string             `json:"old_role,omitempty"`
+	Reason    *string             `json:"reason,omitempty"`
+	UserId    *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// RoleChangesPage defines model for RoleChangesPage.
+type RoleChangesPage struct {
+	Changes    *[]RoleChange `json:"changes,omitempty"`
+	Pagination *Pagination   `json:"pagination,omitempty"`
+}
+
+// UserStats defines model for UserStats.
+type UserStats struct {
+	CoursesCompleted  *int                `json:"courses_completed,omitempty"`
+	CoursesInProgress *int                `json:"courses_in_progress,omitempty"`
+	Followers         *int                `json:"followers,omitempty"`
+	Following         *int                `json:"following,omitempty"`
+	Id                *openapi_types.UUID `json:"id,omitempty"`
+	Level             *int                `json:"level,omitempty"`
+	NextLevelXp       *int                `json:"next_level_xp,omitempty"`
+	UserId            *openapi_types.UUID `json:"user_id,omitempty"`
+	Xp                *int                `json:"xp,omitempty"`
+}
+
+// GetAdminLockoutsParams defines parameters for GetAdminLockouts.
+type GetAdminLockoutsParams struct {
+	// Page Page number, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of events per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAdminRoleChangesParams defines parameters for GetAdminRoleChanges.
+type GetAdminRoleChangesParams struct {
+	// UserId Only list the changes of this user
+	UserId *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// Page Page number, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of changes per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostAdminAchievementsJSONRequestBody defines body for PostAdminAchievements for application/json ContentType.
+type PostAdminAchievementsJSONRequestBody = RewardDefinitionRequest
+
+// PutAdminAchievementsDefinitionIdJSONRequestBody defines body for PutAdminAchievementsDefinitionId for application/json ContentType.
+type PutAdminAchievementsDefinitionIdJSONRequestBody = RewardDefinitionRequest
+
+// PostAdminBadgesJSONRequestBody defines body for PostAdminBadges for application/json ContentType.
+type PostAdminBadgesJSONRequestBody = RewardDefinitionRequest
+
+// PutAdminBadgesDefinitionIdJSONRequestBody defines body for PutAdminBadgesDefinitionId for application/json ContentType.
+type PutAdminBadgesDefinitionIdJSONRequestBody = RewardDefinitionRequest
+
+// PutAdminUsersUserIdRoleJSONRequestBody defines body for PutAdminUsersUserIdRole for application/json ContentType.
+type PutAdminUsersUserIdRoleJSONRequestBody = ChangeRoleRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the achievement catalog
+	// (GET /admin/achievements)
+	GetAdminAchievements(ctx echo.Context) error
+	// Create a achievement definition
+	// (POST /admin/achievements)
+	PostAdminAchievements(ctx echo.Context) error
+	// Delete a achievement definition
+	// (DELETE /admin/achievements/{definition_id})
+	DeleteAdminAchievementsDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error
+	// Get a achievement definition
+	// (GET /admin/achievements/{definition_id})
+	GetAdminAchievementsDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error
+	// Update a achievement definition
+	// (PUT /admin/achievements/{definition_id})
+	PutAdminAchievementsDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error
+	// List the badge catalog
+	// (GET /admin/badges)
+	GetAdminBadges(ctx echo.Context) error
+	// Create a badge definition
+	// (POST /admin/badges)
+	PostAdminBadges(ctx echo.Context) error
+	// Delete a badge definition
+	// (DELETE /admin/badges/{definition_id})
+	DeleteAdminBadgesDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error
+	// Get a badge definition
+	// (GET /admin/badges/{definition_id})
+	GetAdminBadgesDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error
+	// Update a badge definition
+	// (PUT /admin/badges/{definition_id})
+	PutAdminBadgesDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error
+	// List login lockouts caused by repeated failed attempts
+	// (GET /admin/lockouts)
+	GetAdminLockouts(ctx echo.Context, params GetAdminLockoutsParams) error
+	// List role changes made by admins
+	// (GET /admin/role-changes)
+	GetAdminRoleChanges(ctx echo.Context, params GetAdminRoleChangesParams) error
+	// Promote or demote a user
+	// (PUT /admin/users/{user_id}/role)
+	PutAdminUsersUserIdRole(ctx echo.Context, userId openapi_types.UUID) error
+	// Recompute the XP and level of a user
+	// (POST /admin/users/{user_id}/xp/recompute)
+	PostAdminUsersUserIdXpRecompute(ctx echo.Context, userId openapi_types.UUID) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAdminAchievements converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminAchievements(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminAchievements(ctx)
+	return err
+}
+
+// PostAdminAchievements converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminAchievements(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminAchievements(ctx)
+	return err
+}
+
+// DeleteAdminAchievementsDefinitionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminAchievementsDefinitionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "definition_id" -------------
+	var definitionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "definition_id", runtime.ParamLocationPath, ctx.Param("definition_id"), &definitionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter definition_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminAchievementsDefinitionId(ctx, definitionId)
+	return err
+}
+
+// GetAdminAchievementsDefinitionId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminAchievementsDefinitionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "definition_id" -------------
+	var definitionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "definition_id", runtime.ParamLocationPath, ctx.Param("definition_id"), &definitionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter definition_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminAchievementsDefinitionId(ctx, definitionId)
+	return err
+}
+
+// PutAdminAchievementsDefinitionId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminAchievementsDefinitionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "definition_id" -------------
+	var definitionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "definition_id", runtime.ParamLocationPath, ctx.Param("definition_id"), &definitionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter definition_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminAchievementsDefinitionId(ctx, definitionId)
+	return err
+}
+
+// GetAdminBadges converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminBadges(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminBadges(ctx)
+	return err
+}
+
+// PostAdminBadges converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminBadges(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminBadges(ctx)
+	return err
+}
+
+// DeleteAdminBadgesDefinitionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminBadgesDefinitionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "definition_id" -------------
+	var definitionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "definition_id", runtime.ParamLocationPath, ctx.Param("definition_id"), &definitionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter definition_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminBadgesDefinitionId(ctx, definitionId)
+	return err
+}
+
+// GetAdminBadgesDefinitionId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminBadgesDefinitionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "definition_id" -------------
+	var definitionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "definition_id", runtime.ParamLocationPath, ctx.Param("definition_id"), &definitionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter definition_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminBadgesDefinitionId(ctx, definitionId)
+	return err
+}
+
+// PutAdminBadgesDefinitionId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminBadgesDefinitionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "definition_id" -------------
+	var definitionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "definition_id", runtime.ParamLocationPath, ctx.Param("definition_id"), &definitionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter definition_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminBadgesDefinitionId(ctx, definitionId)
+	return err
+}
+
+// GetAdminLockouts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminLockouts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminLockoutsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminLockouts(ctx, params)
+	return err
+}
+
+// GetAdminRoleChanges converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminRoleChanges(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminRoleChangesParams
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminRoleChanges(ctx, params)
+	return err
+}
+
+// PutAdminUsersUserIdRole converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminUsersUserIdRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminUsersUserIdRole(ctx, userId)
+	return err
+}
+
+// PostAdminUsersUserIdXpRecompute converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminUsersUserIdXpRecompute(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminUsersUserIdXpRecompute(ctx, userId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/admin/achievements", wrapper.GetAdminAchievements)
+	router.POST(baseURL+"/admin/achievements", wrapper.PostAdminAchievements)
+	router.DELETE(baseURL+"/admin/achievements/:definition_id", wrapper.DeleteAdminAchievementsDefinitionId)
+	router.GET(baseURL+"/admin/achievements/:definition_id", wrapper.GetAdminAchievementsDefinitionId)
+	router.PUT(baseURL+"/admin/achievements/:definition_id", wrapper.PutAdminAchievementsDefinitionId)
+	router.GET(baseURL+"/admin/badges", wrapper.GetAdminBadges)
+	router.POST(baseURL+"/admin/badges", wrapper.PostAdminBadges)
+	router.DELETE(baseURL+"/admin/badges/:definition_id", wrapper.DeleteAdminBadgesDefinitionId)
+	router.GET(baseURL+"/admin/badges/:definition_id", wrapper.GetAdminBadgesDefinitionId)
+	router.PUT(baseURL+"/admin/badges/:definition_id", wrapper.PutAdminBadgesDefinitionId)
+	router.GET(baseURL+"/admin/lockouts", wrapper.GetAdminLockouts)
+	router.GET(baseURL+"/admin/role-changes", wrapper.GetAdminRoleChanges)
+	router.PUT(baseURL+"/admin/users/:user_id/role", wrapper.PutAdminUsersUserIdRole)
+	router.POST(baseURL+"/admin/users/:user_id/xp/recompute", wrapper.PostAdminUsersUserIdXpRecompute)
+
+}
+
+type GetAdminAchievementsRequestObject struct {
+}
+
+type GetAdminAchievementsResponseObject interface {
+	VisitGetAdminAchievementsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAchievements200JSONResponse []RewardDefinition
+
+func (response GetAdminAchievements200JSONResponse) VisitGetAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievements401JSONResponse Error
+
+func (response GetAdminAchievements401JSONResponse) VisitGetAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievements403JSONResponse Error
+
+func (response GetAdminAchievements403JSONResponse) VisitGetAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievements500JSONResponse Error
+
+func (response GetAdminAchievements500JSONResponse) VisitGetAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminAchievementsRequestObject struct {
+	Body *PostAdminAchievementsJSONRequestBody
+}
+
+type PostAdminAchievementsResponseObject interface {
+	VisitPostAdminAchievementsResponse(w http.ResponseWriter) error
+}
+
+type PostAdminAchievements201JSONResponse RewardDefinition
+
+func (response PostAdminAchievements201JSONResponse) VisitPostAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminAchievements400JSONResponse Error
+
+func (response PostAdminAchievements400JSONResponse) VisitPostAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminAchievements401JSONResponse Error
+
+func (response PostAdminAchievements401JSONResponse) VisitPostAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminAchievements403JSONResponse Error
+
+func (response PostAdminAchievements403JSONResponse) VisitPostAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminAchievements409JSONResponse Error
+
+func (response PostAdminAchievements409JSONResponse) VisitPostAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminAchievements500JSONResponse Error
+
+func (response PostAdminAchievements500JSONResponse) VisitPostAdminAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminAchievementsDefinitionIdRequestObject struct {
+	DefinitionId openapi_types.UUID `json:"definition_id"`
+}
+
+type DeleteAdminAchievementsDefinitionIdResponseObject interface {
+	VisitDeleteAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminAchievementsDefinitionId200JSONResponse Error
+
+func (response DeleteAdminAchievementsDefinitionId200JSONResponse) VisitDeleteAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminAchievementsDefinitionId401JSONResponse Error
+
+func (response DeleteAdminAchievementsDefinitionId401JSONResponse) VisitDeleteAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminAchievementsDefinitionId403JSONResponse Error
+
+func (response DeleteAdminAchievementsDefinitionId403JSONResponse) VisitDeleteAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminAchievementsDefinitionId404JSONResponse Error
+
+func (response DeleteAdminAchievementsDefinitionId404JSONResponse) VisitDeleteAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminAchievementsDefinitionId500JSONResponse Error
+
+func (response DeleteAdminAchievementsDefinitionId500JSONResponse) VisitDeleteAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievementsDefinitionIdRequestObject struct {
+	DefinitionId openapi_types.UUID `json:"definition_id"`
+}
+
+type GetAdminAchievementsDefinitionIdResponseObject interface {
+	VisitGetAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAchievementsDefinitionId200JSONResponse RewardDefinition
+
+func (response GetAdminAchievementsDefinitionId200JSONResponse) VisitGetAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievementsDefinitionId401JSONResponse Error
+
+func (response GetAdminAchievementsDefinitionId401JSONResponse) VisitGetAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievementsDefinitionId403JSONResponse Error
+
+func (response GetAdminAchievementsDefinitionId403JSONResponse) VisitGetAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievementsDefinitionId404JSONResponse Error
+
+func (response GetAdminAchievementsDefinitionId404JSONResponse) VisitGetAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAchievementsDefinitionId500JSONResponse Error
+
+func (response GetAdminAchievementsDefinitionId500JSONResponse) VisitGetAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminAchievementsDefinitionIdRequestObject struct {
+	DefinitionId openapi_types.UUID `json:"definition_id"`
+	Body         *PutAdminAchievementsDefinitionIdJSONRequestBody
+}
+
+type PutAdminAchievementsDefinitionIdResponseObject interface {
+	VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error
+}
+
+type PutAdminAchievementsDefinitionId200JSONResponse RewardDefinition
+
+func (response PutAdminAchievementsDefinitionId200JSONResponse) VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminAchievementsDefinitionId400JSONResponse Error
+
+func (response PutAdminAchievementsDefinitionId400JSONResponse) VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminAchievementsDefinitionId401JSONResponse Error
+
+func (response PutAdminAchievementsDefinitionId401JSONResponse) VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminAchievementsDefinitionId403JSONResponse Error
+
+func (response PutAdminAchievementsDefinitionId403JSONResponse) VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminAchievementsDefinitionId404JSONResponse Error
+
+func (response PutAdminAchievementsDefinitionId404JSONResponse) VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminAchievementsDefinitionId409JSONResponse Error
+
+func (response PutAdminAchievementsDefinitionId409JSONResponse) VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminAchievementsDefinitionId500JSONResponse Error
+
+func (response PutAdminAchievementsDefinitionId500JSONResponse) VisitPutAdminAchievementsDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadgesRequestObject struct {
+}
+
+type GetAdminBadgesResponseObject interface {
+	VisitGetAdminBadgesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminBadges200JSONResponse []RewardDefinition
+
+func (response GetAdminBadges200JSONResponse) VisitGetAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadges401JSONResponse Error
+
+func (response GetAdminBadges401JSONResponse) VisitGetAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadges403JSONResponse Error
+
+func (response GetAdminBadges403JSONResponse) VisitGetAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadges500JSONResponse Error
+
+func (response GetAdminBadges500JSONResponse) VisitGetAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminBadgesRequestObject struct {
+	Body *PostAdminBadgesJSONRequestBody
+}
+
+type PostAdminBadgesResponseObject interface {
+	VisitPostAdminBadgesResponse(w http.ResponseWriter) error
+}
+
+type PostAdminBadges201JSONResponse RewardDefinition
+
+func (response PostAdminBadges201JSONResponse) VisitPostAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminBadges400JSONResponse Error
+
+func (response PostAdminBadges400JSONResponse) VisitPostAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminBadges401JSONResponse Error
+
+func (response PostAdminBadges401JSONResponse) VisitPostAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminBadges403JSONResponse Error
+
+func (response PostAdminBadges403JSONResponse) VisitPostAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminBadges409JSONResponse Error
+
+func (response PostAdminBadges409JSONResponse) VisitPostAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminBadges500JSONResponse Error
+
+func (response PostAdminBadges500JSONResponse) VisitPostAdminBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminBadgesDefinitionIdRequestObject struct {
+	DefinitionId openapi_types.UUID `json:"definition_id"`
+}
+
+type DeleteAdminBadgesDefinitionIdResponseObject interface {
+	VisitDeleteAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminBadgesDefinitionId200JSONResponse Error
+
+func (response DeleteAdminBadgesDefinitionId200JSONResponse) VisitDeleteAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminBadgesDefinitionId401JSONResponse Error
+
+func (response DeleteAdminBadgesDefinitionId401JSONResponse) VisitDeleteAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminBadgesDefinitionId403JSONResponse Error
+
+func (response DeleteAdminBadgesDefinitionId403JSONResponse) VisitDeleteAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminBadgesDefinitionId404JSONResponse Error
+
+func (response DeleteAdminBadgesDefinitionId404JSONResponse) VisitDeleteAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminBadgesDefinitionId500JSONResponse Error
+
+func (response DeleteAdminBadgesDefinitionId500JSONResponse) VisitDeleteAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadgesDefinitionIdRequestObject struct {
+	DefinitionId openapi_types.UUID `json:"definition_id"`
+}
+
+type GetAdminBadgesDefinitionIdResponseObject interface {
+	VisitGetAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminBadgesDefinitionId200JSONResponse RewardDefinition
+
+func (response GetAdminBadgesDefinitionId200JSONResponse) VisitGetAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadgesDefinitionId401JSONResponse Error
+
+func (response GetAdminBadgesDefinitionId401JSONResponse) VisitGetAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadgesDefinitionId403JSONResponse Error
+
+func (response GetAdminBadgesDefinitionId403JSONResponse) VisitGetAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadgesDefinitionId404JSONResponse Error
+
+func (response GetAdminBadgesDefinitionId404JSONResponse) VisitGetAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminBadgesDefinitionId500JSONResponse Error
+
+func (response GetAdminBadgesDefinitionId500JSONResponse) VisitGetAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminBadgesDefinitionIdRequestObject struct {
+	DefinitionId openapi_types.UUID `json:"definition_id"`
+	Body         *PutAdminBadgesDefinitionIdJSONRequestBody
+}
+
+type PutAdminBadgesDefinitionIdResponseObject interface {
+	VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error
+}
+
+type PutAdminBadgesDefinitionId200JSONResponse RewardDefinition
+
+func (response PutAdminBadgesDefinitionId200JSONResponse) VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminBadgesDefinitionId400JSONResponse Error
+
+func (response PutAdminBadgesDefinitionId400JSONResponse) VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminBadgesDefinitionId401JSONResponse Error
+
+func (response PutAdminBadgesDefinitionId401JSONResponse) VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminBadgesDefinitionId403JSONResponse Error
+
+func (response PutAdminBadgesDefinitionId403JSONResponse) VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminBadgesDefinitionId404JSONResponse Error
+
+func (response PutAdminBadgesDefinitionId404JSONResponse) VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminBadgesDefinitionId409JSONResponse Error
+
+func (response PutAdminBadgesDefinitionId409JSONResponse) VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminBadgesDefinitionId500JSONResponse Error
+
+func (response PutAdminBadgesDefinitionId500JSONResponse) VisitPutAdminBadgesDefinitionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLockoutsRequestObject struct {
+	Params GetAdminLockoutsParams
+}
+
+type GetAdminLockoutsResponseObject interface {
+	VisitGetAdminLockoutsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminLockouts200JSONResponse LockoutEventsPage
+
+func (response GetAdminLockouts200JSONResponse) VisitGetAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLockouts400JSONResponse Error
+
+func (response GetAdminLockouts400JSONResponse) VisitGetAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLockouts401JSONResponse Error
+
+func (response GetAdminLockouts401JSONResponse) VisitGetAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLockouts403JSONResponse Error
+
+func (response GetAdminLockouts403JSONResponse) VisitGetAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminLockouts500JSONResponse Error
+
+func (response GetAdminLockouts500JSONResponse) VisitGetAdminLockoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminRoleChangesRequestObject struct {
+	Params GetAdminRoleChangesParams
+}
+
+type GetAdminRoleChangesResponseObject interface {
+	VisitGetAdminRoleChangesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminRoleChanges200JSONResponse RoleChangesPage
+
+func (response GetAdminRoleChanges200JSONResponse) VisitGetAdminRoleChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminRoleChanges400JSONResponse Error
+
+func (response GetAdminRoleChanges400JSONResponse) VisitGetAdminRoleChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminRoleChanges401JSONResponse Error
+
+func (response GetAdminRoleChanges401JSONResponse) VisitGetAdminRoleChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminRoleChanges403JSONResponse Error
+
+func (response GetAdminRoleChanges403JSONResponse) VisitGetAdminRoleChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminRoleChanges500JSONResponse Error
+
+func (response GetAdminRoleChanges500JSONResponse) VisitGetAdminRoleChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminUsersUserIdRoleRequestObject struct {
+	UserId openapi_types.UUID `json:"user_id"`
+	Body   *PutAdminUsersUserIdRoleJSONRequestBody
+}
+
+type PutAdminUsersUserIdRoleResponseObject interface {
+	VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error
+}
+
+type PutAdminUsersUserIdRole200JSONResponse RoleChange
+
+func (response PutAdminUsersUserIdRole200JSONResponse) VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminUsersUserIdRole400JSONResponse Error
+
+func (response PutAdminUsersUserIdRole400JSONResponse) VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminUsersUserIdRole401JSONResponse Error
+
+func (response PutAdminUsersUserIdRole401JSONResponse) VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminUsersUserIdRole403JSONResponse Error
+
+func (response PutAdminUsersUserIdRole403JSONResponse) VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminUsersUserIdRole404JSONResponse Error
+
+func (response PutAdminUsersUserIdRole404JSONResponse) VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminUsersUserIdRole409JSONResponse Error
+
+func (response PutAdminUsersUserIdRole409JSONResponse) VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminUsersUserIdRole500JSONResponse Error
+
+func (response PutAdminUsersUserIdRole500JSONResponse) VisitPutAdminUsersUserIdRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdXpRecomputeRequestObject struct {
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+type PostAdminUsersUserIdXpRecomputeResponseObject interface {
+	VisitPostAdminUsersUserIdXpRecomputeResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdXpRecompute200JSONResponse UserStats
+
+func (response PostAdminUsersUserIdXpRecompute200JSONResponse) VisitPostAdminUsersUserIdXpRecomputeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdXpRecompute401JSONResponse Error
+
+func (response PostAdminUsersUserIdXpRecompute401JSONResponse) VisitPostAdminUsersUserIdXpRecomputeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdXpRecompute403JSONResponse Error
+
+func (response PostAdminUsersUserIdXpRecompute403JSONResponse) VisitPostAdminUsersUserIdXpRecomputeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdXpRecompute404JSONResponse Error
+
+func (response PostAdminUsersUserIdXpRecompute404JSONResponse) VisitPostAdminUsersUserIdXpRecomputeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdXpRecompute500JSONResponse Error
+
+func (response PostAdminUsersUserIdXpRecompute500JSONResponse) VisitPostAdminUsersUserIdXpRecomputeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List the achievement catalog
+	// (GET /admin/achievements)
+	GetAdminAchievements(ctx context.Context, request GetAdminAchievementsRequestObject) (GetAdminAchievementsResponseObject, error)
+	// Create a achievement definition
+	// (POST /admin/achievements)
+	PostAdminAchievements(ctx context.Context, request PostAdminAchievementsRequestObject) (PostAdminAchievementsResponseObject, error)
+	// Delete a achievement definition
+	// (DELETE /admin/achievements/{definition_id})
+	DeleteAdminAchievementsDefinitionId(ctx context.Context, request DeleteAdminAchievementsDefinitionIdRequestObject) (DeleteAdminAchievementsDefinitionIdResponseObject, error)
+	// Get a achievement definition
+	// (GET /admin/achievements/{definition_id})
+	GetAdminAchievementsDefinitionId(ctx context.Context, request GetAdminAchievementsDefinitionIdRequestObject) (GetAdminAchievementsDefinitionIdResponseObject, error)
+	// Update a achievement definition
+	// (PUT /admin/achievements/{definition_id})
+	PutAdminAchievementsDefinitionId(ctx context.Context, request PutAdminAchievementsDefinitionIdRequestObject) (PutAdminAchievementsDefinitionIdResponseObject, error)
+	// List the badge catalog
+	// (GET /admin/badges)
+	GetAdminBadges(ctx context.Context, request GetAdminBadgesRequestObject) (GetAdminBadgesResponseObject, error)
+	// Create a badge definition
+	// (POST /admin/badges)
+	PostAdminBadges(ctx context.Context, request PostAdminBadgesRequestObject) (PostAdminBadgesResponseObject, error)
+	// Delete a badge definition
+	// (DELETE /admin/badges/{definition_id})
+	DeleteAdminBadgesDefinitionId(ctx context.Context, request DeleteAdminBadgesDefinitionIdRequestObject) (DeleteAdminBadgesDefinitionIdResponseObject, error)
+	// Get a badge definition
+	// (GET /admin/badges/{definition_id})
+	GetAdminBadgesDefinitionId(ctx context.Context, request GetAdminBadgesDefinitionIdRequestObject) (GetAdminBadgesDefinitionIdResponseObject, error)
+	// Update a badge definition
+	// (PUT /admin/badges/{definition_id})
+	PutAdminBadgesDefinitionId(ctx context.Context, request PutAdminBadgesDefinitionIdRequestObject) (PutAdminBadgesDefinitionIdResponseObject, error)
+	// List login lockouts caused by repeated failed attempts
+	// (GET /admin/lockouts)
+	GetAdminLockouts(ctx context.Context, request GetAdminLockoutsRequestObject) (GetAdminLockoutsResponseObject, error)
+	// List role changes made by admins
+	// (GET /admin/role-changes)
+	GetAdminRoleChanges(ctx context.Context, request GetAdminRoleChangesRequestObject) (GetAdminRoleChangesResponseObject, error)
+	// Promote or demote a user
+	// (PUT /admin/users/{user_id}/role)
+	PutAdminUsersUserIdRole(ctx context.Context, request PutAdminUsersUserIdRoleRequestObject) (PutAdminUsersUserIdRoleResponseObject, error)
+	// Recompute the XP and level of a user
+	// (POST /admin/users/{user_id}/xp/recompute)
+	PostAdminUsersUserIdXpRecompute(ctx context.Context, request PostAdminUsersUserIdXpRecomputeRequestObject) (PostAdminUsersUserIdXpRecomputeResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAdminAchievements operation middleware
+func (sh *strictHandler) GetAdminAchievements(ctx echo.Context) error {
+	var request GetAdminAchievementsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAchievements(ctx.Request().Context(), request.(GetAdminAchievementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAchievements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminAchievementsResponseObject); ok {
+		return validResponse.VisitGetAdminAchievementsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminAchievements operation middleware
+func (sh *strictHandler) PostAdminAchievements(ctx echo.Context) error {
+	var request PostAdminAchievementsRequestObject
+
+	var body PostAdminAchievementsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminAchievements(ctx.Request().Context(), request.(PostAdminAchievementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminAchievements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminAchievementsResponseObject); ok {
+		return validResponse.VisitPostAdminAchievementsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAdminAchievementsDefinitionId operation middleware
+func (sh *strictHandler) DeleteAdminAchievementsDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error {
+	var request DeleteAdminAchievementsDefinitionIdRequestObject
+
+	request.DefinitionId = definitionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminAchievementsDefinitionId(ctx.Request().Context(), request.(DeleteAdminAchievementsDefinitionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminAchievementsDefinitionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAdminAchievementsDefinitionIdResponseObject); ok {
+		return validResponse.VisitDeleteAdminAchievementsDefinitionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminAchievementsDefinitionId operation middleware
+func (sh *strictHandler) GetAdminAchievementsDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error {
+	var request GetAdminAchievementsDefinitionIdRequestObject
+
+	request.DefinitionId = definitionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAchievementsDefinitionId(ctx.Request().Context(), request.(GetAdminAchievementsDefinitionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAchievementsDefinitionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminAchievementsDefinitionIdResponseObject); ok {
+		return validResponse.VisitGetAdminAchievementsDefinitionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutAdminAchievementsDefinitionId operation middleware
+func (sh *strictHandler) PutAdminAchievementsDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error {
+	var request PutAdminAchievementsDefinitionIdRequestObject
+
+	request.DefinitionId = definitionId
+
+	var body PutAdminAchievementsDefinitionIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAdminAchievementsDefinitionId(ctx.Request().Context(), request.(PutAdminAchievementsDefinitionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAdminAchievementsDefinitionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutAdminAchievementsDefinitionIdResponseObject); ok {
+		return validResponse.VisitPutAdminAchievementsDefinitionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminBadges operation middleware
+func (sh *strictHandler) GetAdminBadges(ctx echo.Context) error {
+	var request GetAdminBadgesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminBadges(ctx.Request().Context(), request.(GetAdminBadgesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminBadges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminBadgesResponseObject); ok {
+		return validResponse.VisitGetAdminBadgesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminBadges operation middleware
+func (sh *strictHandler) PostAdminBadges(ctx echo.Context) error {
+	var request PostAdminBadgesRequestObject
+
+	var body PostAdminBadgesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminBadges(ctx.Request().Context(), request.(PostAdminBadgesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminBadges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminBadgesResponseObject); ok {
+		return validResponse.VisitPostAdminBadgesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAdminBadgesDefinitionId operation middleware
+func (sh *strictHandler) DeleteAdminBadgesDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error {
+	var request DeleteAdminBadgesDefinitionIdRequestObject
+
+	request.DefinitionId = definitionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminBadgesDefinitionId(ctx.Request().Context(), request.(DeleteAdminBadgesDefinitionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminBadgesDefinitionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAdminBadgesDefinitionIdResponseObject); ok {
+		return validResponse.VisitDeleteAdminBadgesDefinitionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminBadgesDefinitionId operation middleware
+func (sh *strictHandler) GetAdminBadgesDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error {
+	var request GetAdminBadgesDefinitionIdRequestObject
+
+	request.DefinitionId = definitionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminBadgesDefinitionId(ctx.Request().Context(), request.(GetAdminBadgesDefinitionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminBadgesDefinitionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminBadgesDefinitionIdResponseObject); ok {
+		return validResponse.VisitGetAdminBadgesDefinitionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutAdminBadgesDefinitionId operation middleware
+func (sh *strictHandler) PutAdminBadgesDefinitionId(ctx echo.Context, definitionId openapi_types.UUID) error {
+	var request PutAdminBadgesDefinitionIdRequestObject
+
+	request.DefinitionId = definitionId
+
+	var body PutAdminBadgesDefinitionIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAdminBadgesDefinitionId(ctx.Request().Context(), request.(PutAdminBadgesDefinitionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAdminBadgesDefinitionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutAdminBadgesDefinitionIdResponseObject); ok {
+		return validResponse.VisitPutAdminBadgesDefinitionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminLockouts operation middleware
+func (sh *strictHandler) GetAdminLockouts(ctx echo.Context, params GetAdminLockoutsParams) error {
+	var request GetAdminLockoutsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminLockouts(ctx.Request().Context(), request.(GetAdminLockoutsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminLockouts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminLockoutsResponseObject); ok {
+		return validResponse.VisitGetAdminLockoutsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminRoleChanges operation middleware
+func (sh *strictHandler) GetAdminRoleChanges(ctx echo.Context, params GetAdminRoleChangesParams) error {
+	var request GetAdminRoleChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminRoleChanges(ctx.Request().Context(), request.(GetAdminRoleChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminRoleChanges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminRoleChangesResponseObject); ok {
+		return validResponse.VisitGetAdminRoleChangesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutAdminUsersUserIdRole operation middleware
+func (sh *strictHandler) PutAdminUsersUserIdRole(ctx echo.Context, userId openapi_types.UUID) error {
+	var request PutAdminUsersUserIdRoleRequestObject
+
+	request.UserId = userId
+
+	var body PutAdminUsersUserIdRoleJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAdminUsersUserIdRole(ctx.Request().Context(), request.(PutAdminUsersUserIdRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAdminUsersUserIdRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutAdminUsersUserIdRoleResponseObject); ok {
+		return validResponse.VisitPutAdminUsersUserIdRoleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminUsersUserIdXpRecompute operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdXpRecompute(ctx echo.Context, userId openapi_types.UUID) error {
+	var request PostAdminUsersUserIdXpRecomputeRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdXpRecompute(ctx.Request().Context(), request.(PostAdminUsersUserIdXpRecomputeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdXpRecompute")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminUsersUserIdXpRecomputeResponseObject); ok {
+		return validResponse.VisitPostAdminUsersUserIdXpRecomputeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -8,19 +8,31 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for OIDCAuthorizeRequestRole.
+const (
+	OIDCAuthorizeRequestRoleStudent OIDCAuthorizeRequestRole = "student"
+	OIDCAuthorizeRequestRoleTutor   OIDCAuthorizeRequestRole = "tutor"
+)
+
 // Defines values for RegisterUserRequestRole.
 const (
-	Admin   RegisterUserRequestRole = "admin"
-	Student RegisterUserRequestRole = "student"
-	Tutor   RegisterUserRequestRole = "tutor"
+	RegisterUserRequestRoleStudent RegisterUserRequestRole = "student"
+	RegisterUserRequestRoleTutor   RegisterUserRequestRole = "tutor"
 )
 
+// EmailRequest defines model for EmailRequest.
+type EmailRequest struct {
+	Email openapi_types.Email `json:"email"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    *int    `json:"code,omitempty"`
@@ -28,6 +40,23 @@ type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// JSONWebKey defines model for JSONWebKey.
+type JSONWebKey struct {
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet defines model for JSONWebKeySet.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -40,6 +69,27 @@ type LoginResponse struct {
 	RefreshToken *string `json:"refresh_token,omitempty"`
 }
 
+// OIDCAuthorization defines model for OIDCAuthorization.
+type OIDCAuthorization struct {
+	AuthorizationUrl string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+// OIDCAuthorizeRequest defines model for OIDCAuthorizeRequest.
+type OIDCAuthorizeRequest struct {
+	// Role Role of the account created on first login, defaults to student
+	Role *OIDCAuthorizeRequestRole `json:"role,omitempty"`
+}
+
+// OIDCAuthorizeRequestRole Role of the account created on first login, defaults to student
+type OIDCAuthorizeRequestRole string
+
+// OIDCCallbackRequest defines model for OIDCCallbackRequest.
+type OIDCCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
 // RefreshTokenRequest defines model for RefreshTokenRequest.
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
@@ -47,37 +97,121 @@ type RefreshTokenRequest struct {
 
 // RegisterUserRequest defines model for RegisterUserRequest.
 type RegisterUserRequest struct {
-	Email     openapi_types.Email     `json:"email"`
-	FirstName string                  `json:"first_name"`
-	LastName  string                  `json:"last_name"`
-	Location  string                  `json:"location"`
-	Password  string                  `json:"password"`
-	Role      RegisterUserRequestRole `json:"role"`
+	Email     openapi_types.Email `json:"email"`
+	FirstName string              `json:"first_name"`
+	LastName  string              `json:"last_name"`
+	Location  string              `json:"location"`
+	Password  string              `json:"password"`
+
+	// Role Admin accounts are only appointed by other admins
+	Role RegisterUserRequestRole `json:"role"`
 }
 
-// RegisterUserRequestRole defines model for RegisterUserRequest.Role.
+// RegisterUserRequestRole Admin accounts are only appointed by other admins
 type RegisterUserRequestRole string
 
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	NewPassword string `json:"new_password"`
+	Token       string `json:"token"`
+}
+
+// TwoFactorChallenge defines model for TwoFactorChallenge.
+type TwoFactorChallenge struct {
+	ChallengeToken string    `json:"challenge_token"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyTwoFactorRequest defines model for VerifyTwoFactorRequest.
+type VerifyTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Code 6 digit TOTP code or a recovery code
+	Code string `json:"code"`
+}
+
+// PostAuth2faVerifyJSONRequestBody defines body for PostAuth2faVerify for application/json ContentType.
+type PostAuth2faVerifyJSONRequestBody = VerifyTwoFactorRequest
+
+// PostAuthForgotPasswordJSONRequestBody defines body for PostAuthForgotPassword for application/json ContentType.
+type PostAuthForgotPasswordJSONRequestBody = EmailRequest
+
 // PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
 type PostAuthLoginJSONRequestBody = LoginRequest
 
+// PostAuthLogoutJSONRequestBody defines body for PostAuthLogout for application/json ContentType.
+type PostAuthLogoutJSONRequestBody = RefreshTokenRequest
+
+// PostAuthLogoutAllJSONRequestBody defines body for PostAuthLogoutAll for application/json ContentType.
+type PostAuthLogoutAllJSONRequestBody = RefreshTokenRequest
+
+// PostAuthOidcProviderAuthorizeJSONRequestBody defines body for PostAuthOidcProviderAuthorize for application/json ContentType.
+type PostAuthOidcProviderAuthorizeJSONRequestBody = OIDCAuthorizeRequest
+
+// PostAuthOidcProviderCallbackJSONRequestBody defines body for PostAuthOidcProviderCallback for application/json ContentType.
+type PostAuthOidcProviderCallbackJSONRequestBody = OIDCCallbackRequest
+
 // PostAuthRefreshTokenJSONRequestBody defines body for PostAuthRefreshToken for application/json ContentType.
 type PostAuthRefreshTokenJSONRequestBody = RefreshTokenRequest
 
 // PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
 type PostAuthRegisterJSONRequestBody = RegisterUserRequest
 
+// PostAuthResendVerificationJSONRequestBody defines body for PostAuthResendVerification for application/json ContentType.
+type PostAuthResendVerificationJSONRequestBody = EmailRequest
+
+// PostAuthResetPasswordJSONRequestBody defines body for PostAuthResetPassword for application/json ContentType.
+type PostAuthResetPasswordJSONRequestBody = ResetPasswordRequest
+
+// PostAuthVerifyEmailJSONRequestBody defines body for PostAuthVerifyEmail for application/json ContentType.
+type PostAuthVerifyEmailJSONRequestBody = VerifyEmailRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Public keys that verify issued tokens
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx echo.Context) error
+	// Complete a login with a TOTP code or a recovery code
+	// (POST /auth/2fa/verify)
+	PostAuth2faVerify(ctx echo.Context) error
+	// Email a password reset link
+	// (POST /auth/forgot-password)
+	PostAuthForgotPassword(ctx echo.Context) error
 	// Login user
 	// (POST /auth/login)
 	PostAuthLogin(ctx echo.Context) error
+	// Log out by revoking the refresh token and its rotations
+	// (POST /auth/logout)
+	PostAuthLogout(ctx echo.Context) error
+	// Log out everywhere by revoking every refresh token of the user
+	// (POST /auth/logout-all)
+	PostAuthLogoutAll(ctx echo.Context) error
+	// Start a login with an OpenID Connect provider
+	// (POST /auth/oidc/{provider}/authorize)
+	PostAuthOidcProviderAuthorize(ctx echo.Context, provider string) error
+	// Finish a login with the code and state the provider redirected back with
+	// (POST /auth/oidc/{provider}/callback)
+	PostAuthOidcProviderCallback(ctx echo.Context, provider string) error
 	// Refresh access token
 	// (POST /auth/refresh-token)
 	PostAuthRefreshToken(ctx echo.Context) error
 	// Register a new user
 	// (POST /auth/register)
 	PostAuthRegister(ctx echo.Context) error
+	// Send a new verification email
+	// (POST /auth/resend-verification)
+	PostAuthResendVerification(ctx echo.Context) error
+	// Set a new password with a reset token and log out of all sessions
+	// (POST /auth/reset-password)
+	PostAuthResetPassword(ctx echo.Context) error
+	// Verify the email address with the token sent by email
+	// (POST /auth/verify-email)
+	PostAuthVerifyEmail(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -85,6 +219,33 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetWellKnownJwksJson converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWellKnownJwksJson(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWellKnownJwksJson(ctx)
+	return err
+}
+
+// PostAuth2faVerify converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuth2faVerify(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuth2faVerify(ctx)
+	return err
+}
+
+// PostAuthForgotPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthForgotPassword(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthForgotPassword(ctx)
+	return err
+}
+
 // PostAuthLogin converts echo context to params.
 func (w *ServerInterfaceWrapper) PostAuthLogin(ctx echo.Context) error {
 	var err error
@@ -94,6 +255,56 @@ func (w *ServerInterfaceWrapper) PostAuthLogin(ctx echo.Context) error {
 	return err
 }
 
+// PostAuthLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthLogout(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthLogout(ctx)
+	return err
+}
+
+// PostAuthLogoutAll converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthLogoutAll(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthLogoutAll(ctx)
+	return err
+}
+
+// PostAuthOidcProviderAuthorize converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthOidcProviderAuthorize(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "provider", runtime.ParamLocationPath, ctx.Param("provider"), &provider)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthOidcProviderAuthorize(ctx, provider)
+	return err
+}
+
+// PostAuthOidcProviderCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthOidcProviderCallback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "provider", runtime.ParamLocationPath, ctx.Param("provider"), &provider)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthOidcProviderCallback(ctx, provider)
+	return err
+}
+
 // PostAuthRefreshToken converts echo context to params.
 func (w *ServerInterfaceWrapper) PostAuthRefreshToken(ctx echo.Context) error {
 	var err error
@@ -112,6 +323,33 @@ func (w *ServerInterfaceWrapper) PostAuthRegister(ctx echo.Context) error {
 	return err
 }
 
+// PostAuthResendVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthResendVerification(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthResendVerification(ctx)
+	return err
+}
+
+// PostAuthResetPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthResetPassword(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthResetPassword(ctx)
+	return err
+}
+
+// PostAuthVerifyEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthVerifyEmail(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthVerifyEmail(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -140,10 +378,132 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.POST(baseURL+"/auth/2fa/verify", wrapper.PostAuth2faVerify)
+	router.POST(baseURL+"/auth/forgot-password", wrapper.PostAuthForgotPassword)
 	router.POST(baseURL+"/auth/login", wrapper.PostAuthLogin)
+	router.POST(baseURL+"/auth/logout", wrapper.PostAuthLogout)
+	router.POST(baseURL+"/auth/logout-all", wrapper.PostAuthLogoutAll)
+	router.POST(baseURL+"/auth/oidc/:provider/authorize", wrapper.PostAuthOidcProviderAuthorize)
+	router.POST(baseURL+"/auth/oidc/:provider/callback", wrapper.PostAuthOidcProviderCallback)
 	router.POST(baseURL+"/auth/refresh-token", wrapper.PostAuthRefreshToken)
 	router.POST(baseURL+"/auth/register", wrapper.PostAuthRegister)
+	router.POST(baseURL+"/auth/resend-verification", wrapper.PostAuthResendVerification)
+	router.POST(baseURL+"/auth/reset-password", wrapper.PostAuthResetPassword)
+	router.POST(baseURL+"/auth/verify-email", wrapper.PostAuthVerifyEmail)
+
+}
+
+type GetWellKnownJwksJsonRequestObject struct {
+}
+
+type GetWellKnownJwksJsonResponseObject interface {
+	VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error
+}
+
+type GetWellKnownJwksJson200ResponseHeaders struct {
+	CacheControl string
+}
+
+type GetWellKnownJwksJson200JSONResponse struct {
+	Body    JSONWebKeySet
+	Headers GetWellKnownJwksJson200ResponseHeaders
+}
+
+func (response GetWellKnownJwksJson200JSONResponse) VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuth2faVerifyRequestObject struct {
+	Body *PostAuth2faVerifyJSONRequestBody
+}
+
+type PostAuth2faVerifyResponseObject interface {
+	VisitPostAuth2faVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostAuth2faVerify200JSONResponse LoginResponse
+
+func (response PostAuth2faVerify200JSONResponse) VisitPostAuth2faVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faVerify400JSONResponse Error
+
+func (response PostAuth2faVerify400JSONResponse) VisitPostAuth2faVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faVerify401JSONResponse Error
+
+func (response PostAuth2faVerify401JSONResponse) VisitPostAuth2faVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faVerify429JSONResponse Error
+
+func (response PostAuth2faVerify429JSONResponse) VisitPostAuth2faVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faVerify500JSONResponse Error
+
+func (response PostAuth2faVerify500JSONResponse) VisitPostAuth2faVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthForgotPasswordRequestObject struct {
+	Body *PostAuthForgotPasswordJSONRequestBody
+}
+
+type PostAuthForgotPasswordResponseObject interface {
+	VisitPostAuthForgotPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostAuthForgotPassword202JSONResponse Error
+
+func (response PostAuthForgotPassword202JSONResponse) VisitPostAuthForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
 
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthForgotPassword400JSONResponse Error
+
+func (response PostAuthForgotPassword400JSONResponse) VisitPostAuthForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthForgotPassword500JSONResponse Error
+
+func (response PostAuthForgotPassword500JSONResponse) VisitPostAuthForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type PostAuthLoginRequestObject struct {
@@ -163,6 +523,15 @@ func (response PostAuthLogin200JSONResponse) VisitPostAuthLoginResponse(w http.R
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostAuthLogin202JSONResponse TwoFactorChallenge
+
+func (response PostAuthLogin202JSONResponse) VisitPostAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PostAuthLogin400JSONResponse Error
 
 func (response PostAuthLogin400JSONResponse) VisitPostAuthLoginResponse(w http.ResponseWriter) error {
@@ -181,6 +550,15 @@ func (response PostAuthLogin401JSONResponse) VisitPostAuthLoginResponse(w http.R
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostAuthLogin429JSONResponse Error
+
+func (response PostAuthLogin429JSONResponse) VisitPostAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PostAuthLogin500JSONResponse Error
 
 func (response PostAuthLogin500JSONResponse) VisitPostAuthLoginResponse(w http.ResponseWriter) error {
@@ -190,138 +568,568 @@ func (response PostAuthLogin500JSONResponse) VisitPostAuthLoginResponse(w http.R
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRefreshTokenRequestObject struct {
-	Body *PostAuthRefreshTokenJSONRequestBody
+type PostAuthLogoutRequestObject struct {
+	Body *PostAuthLogoutJSONRequestBody
 }
 
-type PostAuthRefreshTokenResponseObject interface {
-	VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error
+type PostAuthLogoutResponseObject interface {
+	VisitPostAuthLogoutResponse(w http.ResponseWriter) error
 }
 
-type PostAuthRefreshToken200JSONResponse struct {
-	AccessToken  *string `json:"access_token,omitempty"`
-	RefreshToken *string `json:"refresh_token,omitempty"`
-}
+type PostAuthLogout200JSONResponse Error
 
-func (response PostAuthRefreshToken200JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+func (response PostAuthLogout200JSONResponse) VisitPostAuthLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRefreshToken400JSONResponse Error
+type PostAuthLogout400JSONResponse Error
 
-func (response PostAuthRefreshToken400JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+func (response PostAuthLogout400JSONResponse) VisitPostAuthLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRefreshToken401JSONResponse Error
+type PostAuthLogout401JSONResponse Error
 
-func (response PostAuthRefreshToken401JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+func (response PostAuthLogout401JSONResponse) VisitPostAuthLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRefreshToken500JSONResponse Error
+type PostAuthLogout500JSONResponse Error
 
-func (response PostAuthRefreshToken500JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+func (response PostAuthLogout500JSONResponse) VisitPostAuthLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRegisterRequestObject struct {
-	Body *PostAuthRegisterJSONRequestBody
+type PostAuthLogoutAllRequestObject struct {
+	Body *PostAuthLogoutAllJSONRequestBody
 }
 
-type PostAuthRegisterResponseObject interface {
-	VisitPostAuthRegisterResponse(w http.ResponseWriter) error
+type PostAuthLogoutAllResponseObject interface {
+	VisitPostAuthLogoutAllResponse(w http.ResponseWriter) error
 }
 
-type PostAuthRegister201JSONResponse struct {
-	AccessToken  *string `json:"access_token,omitempty"`
-	RefreshToken *string `json:"refresh_token,omitempty"`
-}
+type PostAuthLogoutAll200JSONResponse Error
 
-func (response PostAuthRegister201JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+func (response PostAuthLogoutAll200JSONResponse) VisitPostAuthLogoutAllResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRegister400JSONResponse struct {
-	Code    *int    `json:"code,omitempty"`
-	Details *string `json:"details,omitempty"`
-	Message *string `json:"message,omitempty"`
-}
+type PostAuthLogoutAll400JSONResponse Error
 
-func (response PostAuthRegister400JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+func (response PostAuthLogoutAll400JSONResponse) VisitPostAuthLogoutAllResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRegister409JSONResponse struct {
-	Code    *int    `json:"code,omitempty"`
-	Message *string `json:"message,omitempty"`
-}
+type PostAuthLogoutAll401JSONResponse Error
 
-func (response PostAuthRegister409JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+func (response PostAuthLogoutAll401JSONResponse) VisitPostAuthLogoutAllResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostAuthRegister500JSONResponse struct {
-	Code    *int    `json:"code,omitempty"`
-	Message *string `json:"message,omitempty"`
-}
+type PostAuthLogoutAll500JSONResponse Error
 
-func (response PostAuthRegister500JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+func (response PostAuthLogoutAll500JSONResponse) VisitPostAuthLogoutAllResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Login user
-	// (POST /auth/login)
-	PostAuthLogin(ctx context.Context, request PostAuthLoginRequestObject) (PostAuthLoginResponseObject, error)
-	// Refresh access token
-	// (POST /auth/refresh-token)
-	PostAuthRefreshToken(ctx context.Context, request PostAuthRefreshTokenRequestObject) (PostAuthRefreshTokenResponseObject, error)
-	// Register a new user
-	// (POST /auth/register)
-	PostAuthRegister(ctx context.Context, request PostAuthRegisterRequestObject) (PostAuthRegisterResponseObject, error)
+type PostAuthOidcProviderAuthorizeRequestObject struct {
+	Provider string `json:"provider"`
+	Body     *PostAuthOidcProviderAuthorizeJSONRequestBody
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
-
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+type PostAuthOidcProviderAuthorizeResponseObject interface {
+	VisitPostAuthOidcProviderAuthorizeResponse(w http.ResponseWriter) error
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+type PostAuthOidcProviderAuthorize200JSONResponse OIDCAuthorization
 
-// PostAuthLogin operation middleware
-func (sh *strictHandler) PostAuthLogin(ctx echo.Context) error {
-	var request PostAuthLoginRequestObject
+func (response PostAuthOidcProviderAuthorize200JSONResponse) VisitPostAuthOidcProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderAuthorize400JSONResponse Error
+
+func (response PostAuthOidcProviderAuthorize400JSONResponse) VisitPostAuthOidcProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderAuthorize404JSONResponse Error
+
+func (response PostAuthOidcProviderAuthorize404JSONResponse) VisitPostAuthOidcProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderAuthorize500JSONResponse Error
+
+func (response PostAuthOidcProviderAuthorize500JSONResponse) VisitPostAuthOidcProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderAuthorize502JSONResponse Error
+
+func (response PostAuthOidcProviderAuthorize502JSONResponse) VisitPostAuthOidcProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderCallbackRequestObject struct {
+	Provider string `json:"provider"`
+	Body     *PostAuthOidcProviderCallbackJSONRequestBody
+}
+
+type PostAuthOidcProviderCallbackResponseObject interface {
+	VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error
+}
+
+type PostAuthOidcProviderCallback200JSONResponse LoginResponse
+
+func (response PostAuthOidcProviderCallback200JSONResponse) VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderCallback202JSONResponse TwoFactorChallenge
+
+func (response PostAuthOidcProviderCallback202JSONResponse) VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderCallback400JSONResponse Error
+
+func (response PostAuthOidcProviderCallback400JSONResponse) VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderCallback401JSONResponse Error
+
+func (response PostAuthOidcProviderCallback401JSONResponse) VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderCallback404JSONResponse Error
+
+func (response PostAuthOidcProviderCallback404JSONResponse) VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderCallback409JSONResponse Error
+
+func (response PostAuthOidcProviderCallback409JSONResponse) VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthOidcProviderCallback500JSONResponse Error
+
+func (response PostAuthOidcProviderCallback500JSONResponse) VisitPostAuthOidcProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRefreshTokenRequestObject struct {
+	Body *PostAuthRefreshTokenJSONRequestBody
+}
+
+type PostAuthRefreshTokenResponseObject interface {
+	VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error
+}
+
+type PostAuthRefreshToken200JSONResponse struct {
+	AccessToken  *string `json:"access_token,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+func (response PostAuthRefreshToken200JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRefreshToken400JSONResponse Error
+
+func (response PostAuthRefreshToken400JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRefreshToken401JSONResponse Error
+
+func (response PostAuthRefreshToken401JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRefreshToken500JSONResponse Error
+
+func (response PostAuthRefreshToken500JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegisterRequestObject struct {
+	Body *PostAuthRegisterJSONRequestBody
+}
+
+type PostAuthRegisterResponseObject interface {
+	VisitPostAuthRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostAuthRegister201JSONResponse struct {
+	AccessToken  *string `json:"access_token,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+func (response PostAuthRegister201JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegister400JSONResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Details *string `json:"details,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuthRegister400JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegister409JSONResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuthRegister409JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegister500JSONResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuthRegister500JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthResendVerificationRequestObject struct {
+	Body *PostAuthResendVerificationJSONRequestBody
+}
+
+type PostAuthResendVerificationResponseObject interface {
+	VisitPostAuthResendVerificationResponse(w http.ResponseWriter) error
+}
+
+type PostAuthResendVerification202JSONResponse Error
+
+func (response PostAuthResendVerification202JSONResponse) VisitPostAuthResendVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthResendVerification400JSONResponse Error
+
+func (response PostAuthResendVerification400JSONResponse) VisitPostAuthResendVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthResendVerification500JSONResponse Error
+
+func (response PostAuthResendVerification500JSONResponse) VisitPostAuthResendVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthResetPasswordRequestObject struct {
+	Body *PostAuthResetPasswordJSONRequestBody
+}
+
+type PostAuthResetPasswordResponseObject interface {
+	VisitPostAuthResetPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostAuthResetPassword200JSONResponse Error
+
+func (response PostAuthResetPassword200JSONResponse) VisitPostAuthResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthResetPassword400JSONResponse Error
+
+func (response PostAuthResetPassword400JSONResponse) VisitPostAuthResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthResetPassword500JSONResponse Error
+
+func (response PostAuthResetPassword500JSONResponse) VisitPostAuthResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthVerifyEmailRequestObject struct {
+	Body *PostAuthVerifyEmailJSONRequestBody
+}
+
+type PostAuthVerifyEmailResponseObject interface {
+	VisitPostAuthVerifyEmailResponse(w http.ResponseWriter) error
+}
+
+type PostAuthVerifyEmail200JSONResponse Error
+
+func (response PostAuthVerifyEmail200JSONResponse) VisitPostAuthVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthVerifyEmail400JSONResponse Error
+
+func (response PostAuthVerifyEmail400JSONResponse) VisitPostAuthVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthVerifyEmail500JSONResponse Error
+
+func (response PostAuthVerifyEmail500JSONResponse) VisitPostAuthVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Public keys that verify issued tokens
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)
+	// Complete a login with a TOTP code or a recovery code
+	// (POST /auth/2fa/verify)
+	PostAuth2faVerify(ctx context.Context, request PostAuth2faVerifyRequestObject) (PostAuth2faVerifyResponseObject, error)
+	// Email a password reset link
+	// (POST /auth/forgot-password)
+	PostAuthForgotPassword(ctx context.Context, request PostAuthForgotPasswordRequestObject) (PostAuthForgotPasswordResponseObject, error)
+	// Login user
+	// (POST /auth/login)
+	PostAuthLogin(ctx context.Context, request PostAuthLoginRequestObject) (PostAuthLoginResponseObject, error)
+	// Log out by revoking the refresh token and its rotations
+	// (POST /auth/logout)
+	PostAuthLogout(ctx context.Context, request PostAuthLogoutRequestObject) (PostAuthLogoutResponseObject, error)
+	// Log out everywhere by revoking every refresh token of the user
+	// (POST /auth/logout-all)
+	PostAuthLogoutAll(ctx context.Context, request PostAuthLogoutAllRequestObject) (PostAuthLogoutAllResponseObject, error)
+	// Start a login with an OpenID Connect provider
+	// (POST /auth/oidc/{provider}/authorize)
+	PostAuthOidcProviderAuthorize(ctx context.Context, request PostAuthOidcProviderAuthorizeRequestObject) (PostAuthOidcProviderAuthorizeResponseObject, error)
+	// Finish a login with the code and state the provider redirected back with
+	// (POST /auth/oidc/{provider}/callback)
+	PostAuthOidcProviderCallback(ctx context.Context, request PostAuthOidcProviderCallbackRequestObject) (PostAuthOidcProviderCallbackResponseObject, error)
+	// Refresh access token
+	// (POST /auth/refresh-token)
+	PostAuthRefreshToken(ctx context.Context, request PostAuthRefreshTokenRequestObject) (PostAuthRefreshTokenResponseObject, error)
+	// Register a new user
+	// (POST /auth/register)
+	PostAuthRegister(ctx context.Context, request PostAuthRegisterRequestObject) (PostAuthRegisterResponseObject, error)
+	// Send a new verification email
+	// (POST /auth/resend-verification)
+	PostAuthResendVerification(ctx context.Context, request PostAuthResendVerificationRequestObject) (PostAuthResendVerificationResponseObject, error)
+	// Set a new password with a reset token and log out of all sessions
+	// (POST /auth/reset-password)
+	PostAuthResetPassword(ctx context.Context, request PostAuthResetPasswordRequestObject) (PostAuthResetPasswordResponseObject, error)
+	// Verify the email address with the token sent by email
+	// (POST /auth/verify-email)
+	PostAuthVerifyEmail(ctx context.Context, request PostAuthVerifyEmailRequestObject) (PostAuthVerifyEmailResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetWellKnownJwksJson operation middleware
+func (sh *strictHandler) GetWellKnownJwksJson(ctx echo.Context) error {
+	var request GetWellKnownJwksJsonRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownJwksJson(ctx.Request().Context(), request.(GetWellKnownJwksJsonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownJwksJson")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetWellKnownJwksJsonResponseObject); ok {
+		return validResponse.VisitGetWellKnownJwksJsonResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuth2faVerify operation middleware
+func (sh *strictHandler) PostAuth2faVerify(ctx echo.Context) error {
+	var request PostAuth2faVerifyRequestObject
+
+	var body PostAuth2faVerifyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuth2faVerify(ctx.Request().Context(), request.(PostAuth2faVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuth2faVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuth2faVerifyResponseObject); ok {
+		return validResponse.VisitPostAuth2faVerifyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthForgotPassword operation middleware
+func (sh *strictHandler) PostAuthForgotPassword(ctx echo.Context) error {
+	var request PostAuthForgotPasswordRequestObject
+
+	var body PostAuthForgotPasswordJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthForgotPassword(ctx.Request().Context(), request.(PostAuthForgotPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthForgotPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthForgotPasswordResponseObject); ok {
+		return validResponse.VisitPostAuthForgotPasswordResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthLogin operation middleware
+func (sh *strictHandler) PostAuthLogin(ctx echo.Context) error {
+	var request PostAuthLoginRequestObject
 
 	var body PostAuthLoginJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
@@ -348,6 +1156,126 @@ func (sh *strictHandler) PostAuthLogin(ctx echo.Context) error {
 	return nil
 }
 
+// PostAuthLogout operation middleware
+func (sh *strictHandler) PostAuthLogout(ctx echo.Context) error {
+	var request PostAuthLogoutRequestObject
+
+	var body PostAuthLogoutJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthLogout(ctx.Request().Context(), request.(PostAuthLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthLogout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthLogoutResponseObject); ok {
+		return validResponse.VisitPostAuthLogoutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthLogoutAll operation middleware
+func (sh *strictHandler) PostAuthLogoutAll(ctx echo.Context) error {
+	var request PostAuthLogoutAllRequestObject
+
+	var body PostAuthLogoutAllJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthLogoutAll(ctx.Request().Context(), request.(PostAuthLogoutAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthLogoutAll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthLogoutAllResponseObject); ok {
+		return validResponse.VisitPostAuthLogoutAllResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthOidcProviderAuthorize operation middleware
+func (sh *strictHandler) PostAuthOidcProviderAuthorize(ctx echo.Context, provider string) error {
+	var request PostAuthOidcProviderAuthorizeRequestObject
+
+	request.Provider = provider
+
+	var body PostAuthOidcProviderAuthorizeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthOidcProviderAuthorize(ctx.Request().Context(), request.(PostAuthOidcProviderAuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthOidcProviderAuthorize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthOidcProviderAuthorizeResponseObject); ok {
+		return validResponse.VisitPostAuthOidcProviderAuthorizeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthOidcProviderCallback operation middleware
+func (sh *strictHandler) PostAuthOidcProviderCallback(ctx echo.Context, provider string) error {
+	var request PostAuthOidcProviderCallbackRequestObject
+
+	request.Provider = provider
+
+	var body PostAuthOidcProviderCallbackJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthOidcProviderCallback(ctx.Request().Context(), request.(PostAuthOidcProviderCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthOidcProviderCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthOidcProviderCallbackResponseObject); ok {
+		return validResponse.VisitPostAuthOidcProviderCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostAuthRefreshToken operation middleware
 func (sh *strictHandler) PostAuthRefreshToken(ctx echo.Context) error {
 	var request PostAuthRefreshTokenRequestObject
@@ -405,3 +1333,90 @@ func (sh *strictHandler) PostAuthRegister(ctx echo.Context) error {
 	}
 	return nil
 }
+
+// PostAuthResendVerification operation middleware
+func (sh *strictHandler) PostAuthResendVerification(ctx echo.Context) error {
+	var request PostAuthResendVerificationRequestObject
+
+	var body PostAuthResendVerificationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthResendVerification(ctx.Request().Context(), request.(PostAuthResendVerificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthResendVerification")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthResendVerificationResponseObject); ok {
+		return validResponse.VisitPostAuthResendVerificationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthResetPassword operation middleware
+func (sh *strictHandler) PostAuthResetPassword(ctx echo.Context) error {
+	var request PostAuthResetPasswordRequestObject
+
+	var body PostAuthResetPasswordJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthResetPassword(ctx.Request().Context(), request.(PostAuthResetPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthResetPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthResetPasswordResponseObject); ok {
+		return validResponse.VisitPostAuthResetPasswordResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthVerifyEmail operation middleware
+func (sh *strictHandler) PostAuthVerifyEmail(ctx echo.Context) error {
+	var request PostAuthVerifyEmailRequestObject
+
+	var body PostAuthVerifyEmailJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthVerifyEmail(ctx.Request().Context(), request.(PostAuthVerifyEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthVerifyEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthVerifyEmailResponseObject); ok {
+		return validResponse.VisitPostAuthVerifyEmailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
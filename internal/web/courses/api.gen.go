@@ -20,22 +20,50 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for GetCoursesParamsSort.
+const (
+	CreatedAt     GetCoursesParamsSort = "created_at"
+	Rating        GetCoursesParamsSort = "rating"
+	StudentsCount GetCoursesParamsSort = "students_count"
+)
+
+// Defines values for GetCoursesParamsOrder.
+const (
+	Asc  GetCoursesParamsOrder = "asc"
+	Desc GetCoursesParamsOrder = "desc"
+)
+
 // Course defines model for Course.
 type Course struct {
-	CategoryId       *openapi_types.UUID `json:"category_id,omitempty"`
-	CompletedLessons *int                `json:"completed_lessons,omitempty"`
-	CreatedAt        *time.Time          `json:"created_at,omitempty"`
-	Description      *string             `json:"description,omitempty"`
-	Duration         *string             `json:"duration,omitempty"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	Progress         *int                `json:"progress,omitempty"`
-	Rating           *float32            `json:"rating,omitempty"`
-	StudentId        *openapi_types.UUID `json:"student_id,omitempty"`
-	StudentsCount    *int                `json:"students_count,omitempty"`
-	Title            *string             `json:"title,omitempty"`
-	TotalLessons     *int                `json:"total_lessons,omitempty"`
-	TutorId          *openapi_types.UUID `json:"tutor_id,omitempty"`
-	UpdatedAt        *time.Time          `json:"updated_at,omitempty"`
+	CategoryId    *openapi_types.UUID `json:"category_id,omitempty"`
+	CreatedAt     *time.Time          `json:"created_at,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Duration      *string             `json:"duration,omitempty"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+	IsArchived    *bool               `json:"is_archived,omitempty"`
+	Rating        *float32            `json:"rating,omitempty"`
+	StudentsCount *int                `json:"students_count,omitempty"`
+	Title         *string             `json:"title,omitempty"`
+	TotalLessons  *int                `json:"total_lessons,omitempty"`
+	TutorId       *openapi_types.UUID `json:"tutor_id,omitempty"`
+	UpdatedAt     *time.Time          `json:"updated_at,omitempty"`
+}
+
+// CoursesPage defines model for CoursesPage.
+type CoursesPage struct {
+	Courses    *[]Course   `json:"courses,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// CreateCourseRequest defines model for CreateCourseRequest.
+type CreateCourseRequest struct {
+	CategoryId  openapi_types.UUID `json:"category_id"`
+	Description string             `json:"description"`
+	Duration    string             `json:"duration"`
+	Title       string             `json:"title"`
+
+	// TutorId Owning tutor. Required when an admin creates a course, ignored for tutors.
+	TutorId *openapi_types.UUID `json:"tutor_id,omitempty"`
 }
 
 // Error defines model for Error.
@@ -45,17 +73,83 @@ type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// Pagination defines model for Pagination.
+type Pagination struct {
+	Limit *int `json:"limit,omitempty"`
+	Page  *int `json:"page,omitempty"`
+	Total *int `json:"total,omitempty"`
+}
+
+// UpdateCourseRequest defines model for UpdateCourseRequest.
+type UpdateCourseRequest struct {
+	CategoryId  *openapi_types.UUID `json:"category_id,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Duration    *string             `json:"duration,omitempty"`
+	Title       *string             `json:"title,omitempty"`
+}
+
 // CourseId defines model for CourseId.
 type CourseId = openapi_types.UUID
 
+// GetCoursesParams defines parameters for GetCourses.
+type GetCoursesParams struct {
+	// Page Page number, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of courses per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// CategoryId Only courses in this category
+	CategoryId *openapi_types.UUID `form:"category_id,omitempty" json:"category_id,omitempty"`
+
+	// TutorId Only courses of this tutor
+	TutorId *openapi_types.UUID `form:"tutor_id,omitempty" json:"tutor_id,omitempty"`
+
+	// MinRating Only courses rated at least this value
+	MinRating *float32 `form:"min_rating,omitempty" json:"min_rating,omitempty"`
+
+	// Q Text search in course title and description
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Sort Field to sort by
+	Sort *GetCoursesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *GetCoursesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetCoursesParamsSort defines parameters for GetCourses.
+type GetCoursesParamsSort string
+
+// GetCoursesParamsOrder defines parameters for GetCourses.
+type GetCoursesParamsOrder string
+
+// PostCoursesJSONRequestBody defines body for PostCourses for application/json ContentType.
+type PostCoursesJSONRequestBody = CreateCourseRequest
+
+// PatchCoursesCourseIdJSONRequestBody defines body for PatchCoursesCourseId for application/json ContentType.
+type PatchCoursesCourseIdJSONRequestBody = UpdateCourseRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get courses
 	// (GET /courses)
-	GetCourses(ctx echo.Context) error
+	GetCourses(ctx echo.Context, params GetCoursesParams) error
+	// Create course
+	// (POST /courses)
+	PostCourses(ctx echo.Context) error
+	// Delete course
+	// (DELETE /courses/{course_id})
+	DeleteCoursesCourseId(ctx echo.Context, courseId CourseId) error
 	// Get course
 	// (GET /courses/{course_id})
 	GetCoursesCourseId(ctx echo.Context, courseId CourseId) error
+	// Update course
+	// (PATCH /courses/{course_id})
+	PatchCoursesCourseId(ctx echo.Context, courseId CourseId) error
+	// Archive course
+	// (POST /courses/{course_id}/archive)
+	PostCoursesCourseIdArchive(ctx echo.Context, courseId CourseId) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -69,8 +163,95 @@ func (w *ServerInterfaceWrapper) GetCourses(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCoursesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tutor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tutor_id", ctx.QueryParams(), &params.TutorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tutor_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "min_rating" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_rating", ctx.QueryParams(), &params.MinRating)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_rating: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCourses(ctx, params)
+	return err
+}
+
+// PostCourses converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCourses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCourses(ctx)
+	err = w.Handler.PostCourses(ctx)
+	return err
+}
+
+// DeleteCoursesCourseId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCoursesCourseId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCoursesCourseId(ctx, courseId)
 	return err
 }
 
@@ -92,6 +273,42 @@ func (w *ServerInterfaceWrapper) GetCoursesCourseId(ctx echo.Context) error {
 	return err
 }
 
+// PatchCoursesCourseId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCoursesCourseId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchCoursesCourseId(ctx, courseId)
+	return err
+}
+
+// PostCoursesCourseIdArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCoursesCourseIdArchive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCoursesCourseIdArchive(ctx, courseId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -121,18 +338,23 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/courses", wrapper.GetCourses)
+	router.POST(baseURL+"/courses", wrapper.PostCourses)
+	router.DELETE(baseURL+"/courses/:course_id", wrapper.DeleteCoursesCourseId)
 	router.GET(baseURL+"/courses/:course_id", wrapper.GetCoursesCourseId)
+	router.PATCH(baseURL+"/courses/:course_id", wrapper.PatchCoursesCourseId)
+	router.POST(baseURL+"/courses/:course_id/archive", wrapper.PostCoursesCourseIdArchive)
 
 }
 
 type GetCoursesRequestObject struct {
+	Params GetCoursesParams
 }
 
 type GetCoursesResponseObject interface {
 	VisitGetCoursesResponse(w http.ResponseWriter) error
 }
 
-type GetCourses200JSONResponse []Course
+type GetCourses200JSONResponse CoursesPage
 
 func (response GetCourses200JSONResponse) VisitGetCoursesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
@@ -141,6 +363,15 @@ func (response GetCourses200JSONResponse) VisitGetCoursesResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCourses400JSONResponse Error
+
+func (response GetCourses400JSONResponse) VisitGetCoursesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCourses401JSONResponse Error
 
 func (response GetCourses401JSONResponse) VisitGetCoursesResponse(w http.ResponseWriter) error {
@@ -159,6 +390,112 @@ func (response GetCourses500JSONResponse) VisitGetCoursesResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostCoursesRequestObject struct {
+	Body *PostCoursesJSONRequestBody
+}
+
+type PostCoursesResponseObject interface {
+	VisitPostCoursesResponse(w http.ResponseWriter) error
+}
+
+type PostCourses201JSONResponse Course
+
+func (response PostCourses201JSONResponse) VisitPostCoursesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCourses400JSONResponse Error
+
+func (response PostCourses400JSONResponse) VisitPostCoursesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCourses401JSONResponse Error
+
+func (response PostCourses401JSONResponse) VisitPostCoursesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCourses403JSONResponse Error
+
+func (response PostCourses403JSONResponse) VisitPostCoursesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCourses500JSONResponse Error
+
+func (response PostCourses500JSONResponse) VisitPostCoursesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseIdRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+}
+
+type DeleteCoursesCourseIdResponseObject interface {
+	VisitDeleteCoursesCourseIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteCoursesCourseId200JSONResponse Error
+
+func (response DeleteCoursesCourseId200JSONResponse) VisitDeleteCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseId401JSONResponse Error
+
+func (response DeleteCoursesCourseId401JSONResponse) VisitDeleteCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseId403JSONResponse Error
+
+func (response DeleteCoursesCourseId403JSONResponse) VisitDeleteCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseId404JSONResponse Error
+
+func (response DeleteCoursesCourseId404JSONResponse) VisitDeleteCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseId500JSONResponse Error
+
+func (response DeleteCoursesCourseId500JSONResponse) VisitDeleteCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCoursesCourseIdRequestObject struct {
 	CourseId CourseId `json:"course_id"`
 }
@@ -185,6 +522,15 @@ func (response GetCoursesCourseId401JSONResponse) VisitGetCoursesCourseIdRespons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCoursesCourseId404JSONResponse Error
+
+func (response GetCoursesCourseId404JSONResponse) VisitGetCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCoursesCourseId500JSONResponse Error
 
 func (response GetCoursesCourseId500JSONResponse) VisitGetCoursesCourseIdResponse(w http.ResponseWriter) error {
@@ -194,14 +540,142 @@ func (response GetCoursesCourseId500JSONResponse) VisitGetCoursesCourseIdRespons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PatchCoursesCourseIdRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+	Body     *PatchCoursesCourseIdJSONRequestBody
+}
+
+type PatchCoursesCourseIdResponseObject interface {
+	VisitPatchCoursesCourseIdResponse(w http.ResponseWriter) error
+}
+
+type PatchCoursesCourseId200JSONResponse Course
+
+func (response PatchCoursesCourseId200JSONResponse) VisitPatchCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCoursesCourseId400JSONResponse Error
+
+func (response PatchCoursesCourseId400JSONResponse) VisitPatchCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCoursesCourseId401JSONResponse Error
+
+func (response PatchCoursesCourseId401JSONResponse) VisitPatchCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCoursesCourseId403JSONResponse Error
+
+func (response PatchCoursesCourseId403JSONResponse) VisitPatchCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCoursesCourseId404JSONResponse Error
+
+func (response PatchCoursesCourseId404JSONResponse) VisitPatchCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCoursesCourseId500JSONResponse Error
+
+func (response PatchCoursesCourseId500JSONResponse) VisitPatchCoursesCourseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdArchiveRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+}
+
+type PostCoursesCourseIdArchiveResponseObject interface {
+	VisitPostCoursesCourseIdArchiveResponse(w http.ResponseWriter) error
+}
+
+type PostCoursesCourseIdArchive200JSONResponse Course
+
+func (response PostCoursesCourseIdArchive200JSONResponse) VisitPostCoursesCourseIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdArchive401JSONResponse Error
+
+func (response PostCoursesCourseIdArchive401JSONResponse) VisitPostCoursesCourseIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdArchive403JSONResponse Error
+
+func (response PostCoursesCourseIdArchive403JSONResponse) VisitPostCoursesCourseIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdArchive404JSONResponse Error
+
+func (response PostCoursesCourseIdArchive404JSONResponse) VisitPostCoursesCourseIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdArchive500JSONResponse Error
+
+func (response PostCoursesCourseIdArchive500JSONResponse) VisitPostCoursesCourseIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Get courses
 	// (GET /courses)
 	GetCourses(ctx context.Context, request GetCoursesRequestObject) (GetCoursesResponseObject, error)
+	// Create course
+	// (POST /courses)
+	PostCourses(ctx context.Context, request PostCoursesRequestObject) (PostCoursesResponseObject, error)
+	// Delete course
+	// (DELETE /courses/{course_id})
+	DeleteCoursesCourseId(ctx context.Context, request DeleteCoursesCourseIdRequestObject) (DeleteCoursesCourseIdResponseObject, error)
 	// Get course
 	// (GET /courses/{course_id})
 	GetCoursesCourseId(ctx context.Context, request GetCoursesCourseIdRequestObject) (GetCoursesCourseIdResponseObject, error)
+	// Update course
+	// (PATCH /courses/{course_id})
+	PatchCoursesCourseId(ctx context.Context, request PatchCoursesCourseIdRequestObject) (PatchCoursesCourseIdResponseObject, error)
+	// Archive course
+	// (POST /courses/{course_id}/archive)
+	PostCoursesCourseIdArchive(ctx context.Context, request PostCoursesCourseIdArchiveRequestObject) (PostCoursesCourseIdArchiveResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -217,9 +691,11 @@ type strictHandler struct {
 }
 
 // GetCourses operation middleware
-func (sh *strictHandler) GetCourses(ctx echo.Context) error {
+func (sh *strictHandler) GetCourses(ctx echo.Context, params GetCoursesParams) error {
 	var request GetCoursesRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetCourses(ctx.Request().Context(), request.(GetCoursesRequestObject))
 	}
@@ -239,6 +715,60 @@ func (sh *strictHandler) GetCourses(ctx echo.Context) error {
 	return nil
 }
 
+// PostCourses operation middleware
+func (sh *strictHandler) PostCourses(ctx echo.Context) error {
+	var request PostCoursesRequestObject
+
+	var body PostCoursesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCourses(ctx.Request().Context(), request.(PostCoursesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCourses")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCoursesResponseObject); ok {
+		return validResponse.VisitPostCoursesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCoursesCourseId operation middleware
+func (sh *strictHandler) DeleteCoursesCourseId(ctx echo.Context, courseId CourseId) error {
+	var request DeleteCoursesCourseIdRequestObject
+
+	request.CourseId = courseId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCoursesCourseId(ctx.Request().Context(), request.(DeleteCoursesCourseIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCoursesCourseId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCoursesCourseIdResponseObject); ok {
+		return validResponse.VisitDeleteCoursesCourseIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetCoursesCourseId operation middleware
 func (sh *strictHandler) GetCoursesCourseId(ctx echo.Context, courseId CourseId) error {
 	var request GetCoursesCourseIdRequestObject
@@ -263,3 +793,59 @@ func (sh *strictHandler) GetCoursesCourseId(ctx echo.Context, courseId CourseId)
 	}
 	return nil
 }
+
+// PatchCoursesCourseId operation middleware
+func (sh *strictHandler) PatchCoursesCourseId(ctx echo.Context, courseId CourseId) error {
+	var request PatchCoursesCourseIdRequestObject
+
+	request.CourseId = courseId
+
+	var body PatchCoursesCourseIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCoursesCourseId(ctx.Request().Context(), request.(PatchCoursesCourseIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCoursesCourseId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCoursesCourseIdResponseObject); ok {
+		return validResponse.VisitPatchCoursesCourseIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCoursesCourseIdArchive operation middleware
+func (sh *strictHandler) PostCoursesCourseIdArchive(ctx echo.Context, courseId CourseId) error {
+	var request PostCoursesCourseIdArchiveRequestObject
+
+	request.CourseId = courseId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCoursesCourseIdArchive(ctx.Request().Context(), request.(PostCoursesCourseIdArchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCoursesCourseIdArchive")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCoursesCourseIdArchiveResponseObject); ok {
+		return validResponse.VisitPostCoursesCourseIdArchiveResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -0,0 +1,474 @@
+// Package enrollments provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package enrollments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Enrollment defines model for Enrollment.
+type Enrollment struct {
+	CompletedLessons *int                `json:"completed_lessons,omitempty"`
+	CourseId         *openapi_types.UUID `json:"course_id,omitempty"`
+	CreatedAt        *time.Time          `json:"created_at,omitempty"`
+	Id               *openapi_types.UUID `json:"id,omitempty"`
+	NextLessonId     *openapi_types.UUID `json:"next_lesson_id,omitempty"`
+	Progress         *int                `json:"progress,omitempty"`
+	StudentId        *openapi_types.UUID `json:"student_id,omitempty"`
+	UpdatedAt        *time.Time          `json:"updated_at,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code    *int    `json:"code,omitempty"`
+	Details *string `json:"details,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// CourseId defines model for CourseId.
+type CourseId = openapi_types.UUID
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Unenroll the current student from a course
+	// (DELETE /courses/{course_id}/enrollment)
+	DeleteCoursesCourseIdEnrollment(ctx echo.Context, courseId CourseId) error
+	// Get the current student's enrollment in a course
+	// (GET /courses/{course_id}/enrollment)
+	GetCoursesCourseIdEnrollment(ctx echo.Context, courseId CourseId) error
+	// Enroll the current student in a course
+	// (POST /courses/{course_id}/enrollment)
+	PostCoursesCourseIdEnrollment(ctx echo.Context, courseId CourseId) error
+	// Get the current student's enrollments
+	// (GET /enrollments)
+	GetEnrollments(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// DeleteCoursesCourseIdEnrollment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCoursesCourseIdEnrollment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCoursesCourseIdEnrollment(ctx, courseId)
+	return err
+}
+
+// GetCoursesCourseIdEnrollment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCoursesCourseIdEnrollment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCoursesCourseIdEnrollment(ctx, courseId)
+	return err
+}
+
+// PostCoursesCourseIdEnrollment converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCoursesCourseIdEnrollment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCoursesCourseIdEnrollment(ctx, courseId)
+	return err
+}
+
+// GetEnrollments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEnrollments(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEnrollments(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.DELETE(baseURL+"/courses/:course_id/enrollment", wrapper.DeleteCoursesCourseIdEnrollment)
+	router.GET(baseURL+"/courses/:course_id/enrollment", wrapper.GetCoursesCourseIdEnrollment)
+	router.POST(baseURL+"/courses/:course_id/enrollment", wrapper.PostCoursesCourseIdEnrollment)
+	router.GET(baseURL+"/enrollments", wrapper.GetEnrollments)
+
+}
+
+type DeleteCoursesCourseIdEnrollmentRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+}
+
+type DeleteCoursesCourseIdEnrollmentResponseObject interface {
+	VisitDeleteCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type DeleteCoursesCourseIdEnrollment200JSONResponse Error
+
+func (response DeleteCoursesCourseIdEnrollment200JSONResponse) VisitDeleteCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseIdEnrollment401JSONResponse Error
+
+func (response DeleteCoursesCourseIdEnrollment401JSONResponse) VisitDeleteCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseIdEnrollment404JSONResponse Error
+
+func (response DeleteCoursesCourseIdEnrollment404JSONResponse) VisitDeleteCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCoursesCourseIdEnrollment500JSONResponse Error
+
+func (response DeleteCoursesCourseIdEnrollment500JSONResponse) VisitDeleteCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCoursesCourseIdEnrollmentRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+}
+
+type GetCoursesCourseIdEnrollmentResponseObject interface {
+	VisitGetCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type GetCoursesCourseIdEnrollment200JSONResponse Enrollment
+
+func (response GetCoursesCourseIdEnrollment200JSONResponse) VisitGetCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCoursesCourseIdEnrollment401JSONResponse Error
+
+func (response GetCoursesCourseIdEnrollment401JSONResponse) VisitGetCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCoursesCourseIdEnrollment404JSONResponse Error
+
+func (response GetCoursesCourseIdEnrollment404JSONResponse) VisitGetCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCoursesCourseIdEnrollment500JSONResponse Error
+
+func (response GetCoursesCourseIdEnrollment500JSONResponse) VisitGetCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdEnrollmentRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+}
+
+type PostCoursesCourseIdEnrollmentResponseObject interface {
+	VisitPostCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type PostCoursesCourseIdEnrollment201JSONResponse Enrollment
+
+func (response PostCoursesCourseIdEnrollment201JSONResponse) VisitPostCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdEnrollment401JSONResponse Error
+
+func (response PostCoursesCourseIdEnrollment401JSONResponse) VisitPostCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdEnrollment403JSONResponse Error
+
+func (response PostCoursesCourseIdEnrollment403JSONResponse) VisitPostCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdEnrollment404JSONResponse Error
+
+func (response PostCoursesCourseIdEnrollment404JSONResponse) VisitPostCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdEnrollment409JSONResponse Error
+
+func (response PostCoursesCourseIdEnrollment409JSONResponse) VisitPostCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCoursesCourseIdEnrollment500JSONResponse Error
+
+func (response PostCoursesCourseIdEnrollment500JSONResponse) VisitPostCoursesCourseIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnrollmentsRequestObject struct {
+}
+
+type GetEnrollmentsResponseObject interface {
+	VisitGetEnrollmentsResponse(w http.ResponseWriter) error
+}
+
+type GetEnrollments200JSONResponse []Enrollment
+
+func (response GetEnrollments200JSONResponse) VisitGetEnrollmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnrollments401JSONResponse Error
+
+func (response GetEnrollments401JSONResponse) VisitGetEnrollmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnrollments500JSONResponse Error
+
+func (response GetEnrollments500JSONResponse) VisitGetEnrollmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Unenroll the current student from a course
+	// (DELETE /courses/{course_id}/enrollment)
+	DeleteCoursesCourseIdEnrollment(ctx context.Context, request DeleteCoursesCourseIdEnrollmentRequestObject) (DeleteCoursesCourseIdEnrollmentResponseObject, error)
+	// Get the current student's enrollment in a course
+	// (GET /courses/{course_id}/enrollment)
+	GetCoursesCourseIdEnrollment(ctx context.Context, request GetCoursesCourseIdEnrollmentRequestObject) (GetCoursesCourseIdEnrollmentResponseObject, error)
+	// Enroll the current student in a course
+	// (POST /courses/{course_id}/enrollment)
+	PostCoursesCourseIdEnrollment(ctx context.Context, request PostCoursesCourseIdEnrollmentRequestObject) (PostCoursesCourseIdEnrollmentResponseObject, error)
+	// Get the current student's enrollments
+	// (GET /enrollments)
+	GetEnrollments(ctx context.Context, request GetEnrollmentsRequestObject) (GetEnrollmentsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// DeleteCoursesCourseIdEnrollment operation middleware
+func (sh *strictHandler) DeleteCoursesCourseIdEnrollment(ctx echo.Context, courseId CourseId) error {
+	var request DeleteCoursesCourseIdEnrollmentRequestObject
+
+	request.CourseId = courseId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCoursesCourseIdEnrollment(ctx.Request().Context(), request.(DeleteCoursesCourseIdEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCoursesCourseIdEnrollment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCoursesCourseIdEnrollmentResponseObject); ok {
+		return validResponse.VisitDeleteCoursesCourseIdEnrollmentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCoursesCourseIdEnrollment operation middleware
+func (sh *strictHandler) GetCoursesCourseIdEnrollment(ctx echo.Context, courseId CourseId) error {
+	var request GetCoursesCourseIdEnrollmentRequestObject
+
+	request.CourseId = courseId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCoursesCourseIdEnrollment(ctx.Request().Context(), request.(GetCoursesCourseIdEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCoursesCourseIdEnrollment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCoursesCourseIdEnrollmentResponseObject); ok {
+		return validResponse.VisitGetCoursesCourseIdEnrollmentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCoursesCourseIdEnrollment operation middleware
+func (sh *strictHandler) PostCoursesCourseIdEnrollment(ctx echo.Context, courseId CourseId) error {
+	var request PostCoursesCourseIdEnrollmentRequestObject
+
+	request.CourseId = courseId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCoursesCourseIdEnrollment(ctx.Request().Context(), request.(PostCoursesCourseIdEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCoursesCourseIdEnrollment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCoursesCourseIdEnrollmentResponseObject); ok {
+		return validResponse.VisitPostCoursesCourseIdEnrollmentResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetEnrollments operation middleware
+func (sh *strictHandler) GetEnrollments(ctx echo.Context) error {
+	var request GetEnrollmentsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEnrollments(ctx.Request().Context(), request.(GetEnrollmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEnrollments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEnrollmentsResponseObject); ok {
+		return validResponse.VisitGetEnrollmentsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
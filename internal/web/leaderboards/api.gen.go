@@ -0,0 +1,273 @@
+// Package leaderboards provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package leaderboards
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for LeaderboardPeriod.
+const (
+	LeaderboardPeriodAllTime LeaderboardPeriod = "all_time"
+	LeaderboardPeriodMonth   LeaderboardPeriod = "month"
+	LeaderboardPeriodWeek    LeaderboardPeriod = "week"
+)
+
+// Defines values for GetLeaderboardsParamsPeriod.
+const (
+	GetLeaderboardsParamsPeriodAllTime GetLeaderboardsParamsPeriod = "all_time"
+	GetLeaderboardsParamsPeriodMonth   GetLeaderboardsParamsPeriod = "month"
+	GetLeaderboardsParamsPeriodWeek    GetLeaderboardsParamsPeriod = "week"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Code    *int    `json:"code,omitempty"`
+	Details *string `json:"details,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// Leaderboard defines model for Leaderboard.
+type Leaderboard struct {
+	CategoryId *openapi_types.UUID `json:"category_id,omitempty"`
+	Entries    *[]LeaderboardEntry `json:"entries,omitempty"`
+	Me         *LeaderboardEntry   `json:"me,omitempty"`
+	Pagination *Pagination         `json:"pagination,omitempty"`
+	Period     *LeaderboardPeriod  `json:"period,omitempty"`
+}
+
+// LeaderboardPeriod defines model for Leaderboard.Period.
+type LeaderboardPeriod string
+
+// LeaderboardEntry defines model for LeaderboardEntry.
+type LeaderboardEntry struct {
+	Avatar    *string             `json:"avatar,omitempty"`
+	FirstName *string             `json:"first_name,omitempty"`
+	LastName  *string             `json:"last_name,omitempty"`
+	Level     *int                `json:"level,omitempty"`
+	Rank      *int                `json:"rank,omitempty"`
+	UserId    *openapi_types.UUID `json:"user_id,omitempty"`
+
+	// Xp XP earned in the period and category of the leaderboard
+	Xp *int `json:"xp,omitempty"`
+}
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	Limit *int `json:"limit,omitempty"`
+	Page  *int `json:"page,omitempty"`
+	Total *int `json:"total,omitempty"`
+}
+
+// GetLeaderboardsParams defines parameters for GetLeaderboards.
+type GetLeaderboardsParams struct {
+	// Period Count all XP, XP since Monday or XP since the first of the month (UTC)
+	Period *GetLeaderboardsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// CategoryId Only count XP earned in courses of this category
+	CategoryId *openapi_types.UUID `form:"category_id,omitempty" json:"category_id,omitempty"`
+
+	// Page Page number, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of entries per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetLeaderboardsParamsPeriod defines parameters for GetLeaderboards.
+type GetLeaderboardsParamsPeriod string
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Rank users by XP
+	// (GET /leaderboards)
+	GetLeaderboards(ctx echo.Context, params GetLeaderboardsParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetLeaderboards converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLeaderboards(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaderboardsParams
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", ctx.QueryParams(), &params.Period)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category_id", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLeaderboards(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/leaderboards", wrapper.GetLeaderboards)
+
+}
+
+type GetLeaderboardsRequestObject struct {
+	Params GetLeaderboardsParams
+}
+
+type GetLeaderboardsResponseObject interface {
+	VisitGetLeaderboardsResponse(w http.ResponseWriter) error
+}
+
+type GetLeaderboards200JSONResponse Leaderboard
+
+func (response GetLeaderboards200JSONResponse) VisitGetLeaderboardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboards400JSONResponse Error
+
+func (response GetLeaderboards400JSONResponse) VisitGetLeaderboardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboards401JSONResponse Error
+
+func (response GetLeaderboards401JSONResponse) VisitGetLeaderboardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboards404JSONResponse Error
+
+func (response GetLeaderboards404JSONResponse) VisitGetLeaderboardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboards500JSONResponse Error
+
+func (response GetLeaderboards500JSONResponse) VisitGetLeaderboardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Rank users by XP
+	// (GET /leaderboards)
+	GetLeaderboards(ctx context.Context, request GetLeaderboardsRequestObject) (GetLeaderboardsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetLeaderboards operation middleware
+func (sh *strictHandler) GetLeaderboards(ctx echo.Context, params GetLeaderboardsParams) error {
+	var request GetLeaderboardsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLeaderboards(ctx.Request().Context(), request.(GetLeaderboardsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLeaderboards")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetLeaderboardsResponseObject); ok {
+		return validResponse.VisitGetLeaderboardsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -20,6 +20,13 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for LessonStatus.
+const (
+	Archived  LessonStatus = "archived"
+	Draft     LessonStatus = "draft"
+	Published LessonStatus = "published"
+)
+
 // CreateLessonRequest defines model for CreateLessonRequest.
 type CreateLessonRequest struct {
 	CourseId    openapi_types.UUID `json:"course_id"`
@@ -29,6 +36,18 @@ type CreateLessonRequest struct {
 	VideoUrl    string             `json:"video_url"`
 }
 
+// Enrollment defines model for Enrollment.
+type Enrollment struct {
+	CompletedLessons *int                `json:"completed_lessons,omitempty"`
+	CourseId         *openapi_types.UUID `json:"course_id,omitempty"`
+	CreatedAt        *time.Time          `json:"created_at,omitempty"`
+	Id               *openapi_types.UUID `json:"id,omitempty"`
+	NextLessonId     *openapi_types.UUID `json:"next_lesson_id,omitempty"`
+	Progress         *int                `json:"progress,omitempty"`
+	StudentId        *openapi_types.UUID `json:"student_id,omitempty"`
+	UpdatedAt        *time.Time          `json:"updated_at,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    *int    `json:"code,omitempty"`
@@ -43,25 +62,85 @@ type Lesson struct {
 	Description string             `json:"description"`
 	Duration    string             `json:"duration"`
 	Id          openapi_types.UUID `json:"id"`
-	Title       string             `json:"title"`
-	UpdatedAt   time.Time          `json:"updated_at"`
-	VideoUrl    string             `json:"video_url"`
+
+	// Position Position of the lesson within its course, starting from 1
+	Position int `json:"position"`
+
+	// Status Only published lessons are visible to students
+	Status    LessonStatus `json:"status"`
+	Title     string       `json:"title"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	VideoUrl  string       `json:"video_url"`
+}
+
+// LessonStatus Only published lessons are visible to students
+type LessonStatus string
+
+// LessonCompletion defines model for LessonCompletion.
+type LessonCompletion struct {
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+	Enrollment  *Enrollment         `json:"enrollment,omitempty"`
+	LessonId    *openapi_types.UUID `json:"lesson_id,omitempty"`
+}
+
+// ReorderLessonsRequest defines model for ReorderLessonsRequest.
+type ReorderLessonsRequest struct {
+	// LessonIds Every lesson of the course, in the desired order
+	LessonIds []openapi_types.UUID `json:"lesson_ids"`
+}
+
+// UpdateLessonRequest defines model for UpdateLessonRequest.
+type UpdateLessonRequest struct {
+	Description *string `json:"description,omitempty"`
+	Duration    *string `json:"duration,omitempty"`
+	Title       *string `json:"title,omitempty"`
+	VideoUrl    *string `json:"video_url,omitempty"`
 }
 
-// CreaterId defines model for CreaterId.
-type CreaterId = openapi_types.UUID
+// CourseId defines model for CourseId.
+type CourseId = openapi_types.UUID
 
-// PostLessonsCreaterIdJSONRequestBody defines body for PostLessonsCreaterId for application/json ContentType.
-type PostLessonsCreaterIdJSONRequestBody = CreateLessonRequest
+// LessonId defines model for LessonId.
+type LessonId = openapi_types.UUID
+
+// PutCoursesCourseIdLessonsOrderJSONRequestBody defines body for PutCoursesCourseIdLessonsOrder for application/json ContentType.
+type PutCoursesCourseIdLessonsOrderJSONRequestBody = ReorderLessonsRequest
+
+// PostLessonsJSONRequestBody defines body for PostLessons for application/json ContentType.
+type PostLessonsJSONRequestBody = CreateLessonRequest
+
+// PatchLessonsLessonIdJSONRequestBody defines body for PatchLessonsLessonId for application/json ContentType.
+type PatchLessonsLessonIdJSONRequestBody = UpdateLessonRequest
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the lessons of a course in author-defined order
+	// (GET /courses/{course_id}/lessons)
+	GetCoursesCourseIdLessons(ctx echo.Context, courseId CourseId) error
+	// Reorder the lessons of a course (owning tutor or admin)
+	// (PUT /courses/{course_id}/lessons/order)
+	PutCoursesCourseIdLessonsOrder(ctx echo.Context, courseId CourseId) error
 	// Get lessons
 	// (GET /lessons)
 	GetLessons(ctx echo.Context) error
-	// Create lesson
-	// (POST /lessons/{creater_id})
-	PostLessonsCreaterId(ctx echo.Context, createrId CreaterId) error
+	// Create a lesson in a course (owning tutor or admin)
+	// (POST /lessons)
+	PostLessons(ctx echo.Context) error
+	// Delete a lesson (owning tutor or admin)
+	// (DELETE /lessons/{lesson_id})
+	DeleteLessonsLessonId(ctx echo.Context, lessonId LessonId) error
+	// Update a lesson (owning tutor or admin)
+	// (PATCH /lessons/{lesson_id})
+	PatchLessonsLessonId(ctx echo.Context, lessonId LessonId) error
+	// Archive a published lesson (owning tutor or admin)
+	// (POST /lessons/{lesson_id}/archive)
+	PostLessonsLessonIdArchive(ctx echo.Context, lessonId LessonId) error
+	// Mark a lesson as completed by the current student
+	// (POST /lessons/{lesson_id}/complete)
+	PostLessonsLessonIdComplete(ctx echo.Context, lessonId LessonId) error
+	// Publish a draft or archived lesson (owning tutor or admin)
+	// (POST /lessons/{lesson_id}/publish)
+	PostLessonsLessonIdPublish(ctx echo.Context, lessonId LessonId) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -69,6 +148,42 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetCoursesCourseIdLessons converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCoursesCourseIdLessons(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCoursesCourseIdLessons(ctx, courseId)
+	return err
+}
+
+// PutCoursesCourseIdLessonsOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCoursesCourseIdLessonsOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, ctx.Param("course_id"), &courseId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter course_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCoursesCourseIdLessonsOrder(ctx, courseId)
+	return err
+}
+
 // GetLessons converts echo context to params.
 func (w *ServerInterfaceWrapper) GetLessons(ctx echo.Context) error {
 	var err error
@@ -80,21 +195,104 @@ func (w *ServerInterfaceWrapper) GetLessons(ctx echo.Context) error {
 	return err
 }
 
-// PostLessonsCreaterId converts echo context to params.
-func (w *ServerInterfaceWrapper) PostLessonsCreaterId(ctx echo.Context) error {
+// PostLessons converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLessons(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLessons(ctx)
+	return err
+}
+
+// DeleteLessonsLessonId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteLessonsLessonId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lesson_id" -------------
+	var lessonId LessonId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lesson_id", runtime.ParamLocationPath, ctx.Param("lesson_id"), &lessonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lesson_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteLessonsLessonId(ctx, lessonId)
+	return err
+}
+
+// PatchLessonsLessonId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchLessonsLessonId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lesson_id" -------------
+	var lessonId LessonId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lesson_id", runtime.ParamLocationPath, ctx.Param("lesson_id"), &lessonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lesson_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchLessonsLessonId(ctx, lessonId)
+	return err
+}
+
+// PostLessonsLessonIdArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLessonsLessonIdArchive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lesson_id" -------------
+	var lessonId LessonId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lesson_id", runtime.ParamLocationPath, ctx.Param("lesson_id"), &lessonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lesson_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLessonsLessonIdArchive(ctx, lessonId)
+	return err
+}
+
+// PostLessonsLessonIdComplete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLessonsLessonIdComplete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lesson_id" -------------
+	var lessonId LessonId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lesson_id", runtime.ParamLocationPath, ctx.Param("lesson_id"), &lessonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lesson_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLessonsLessonIdComplete(ctx, lessonId)
+	return err
+}
+
+// PostLessonsLessonIdPublish converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLessonsLessonIdPublish(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "creater_id" -------------
-	var createrId CreaterId
+	// ------------- Path parameter "lesson_id" -------------
+	var lessonId LessonId
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "creater_id", runtime.ParamLocationPath, ctx.Param("creater_id"), &createrId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lesson_id", runtime.ParamLocationPath, ctx.Param("lesson_id"), &lessonId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter creater_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lesson_id: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostLessonsCreaterId(ctx, createrId)
+	err = w.Handler.PostLessonsLessonIdPublish(ctx, lessonId)
 	return err
 }
 
@@ -126,9 +324,123 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/courses/:course_id/lessons", wrapper.GetCoursesCourseIdLessons)
+	router.PUT(baseURL+"/courses/:course_id/lessons/order", wrapper.PutCoursesCourseIdLessonsOrder)
 	router.GET(baseURL+"/lessons", wrapper.GetLessons)
-	router.POST(baseURL+"/lessons/:creater_id", wrapper.PostLessonsCreaterId)
+	router.POST(baseURL+"/lessons", wrapper.PostLessons)
+	router.DELETE(baseURL+"/lessons/:lesson_id", wrapper.DeleteLessonsLessonId)
+	router.PATCH(baseURL+"/lessons/:lesson_id", wrapper.PatchLessonsLessonId)
+	router.POST(baseURL+"/lessons/:lesson_id/archive", wrapper.PostLessonsLessonIdArchive)
+	router.POST(baseURL+"/lessons/:lesson_id/complete", wrapper.PostLessonsLessonIdComplete)
+	router.POST(baseURL+"/lessons/:lesson_id/publish", wrapper.PostLessonsLessonIdPublish)
+
+}
+
+type GetCoursesCourseIdLessonsRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+}
+
+type GetCoursesCourseIdLessonsResponseObject interface {
+	VisitGetCoursesCourseIdLessonsResponse(w http.ResponseWriter) error
+}
+
+type GetCoursesCourseIdLessons200JSONResponse []Lesson
+
+func (response GetCoursesCourseIdLessons200JSONResponse) VisitGetCoursesCourseIdLessonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCoursesCourseIdLessons401JSONResponse Error
+
+func (response GetCoursesCourseIdLessons401JSONResponse) VisitGetCoursesCourseIdLessonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCoursesCourseIdLessons404JSONResponse Error
+
+func (response GetCoursesCourseIdLessons404JSONResponse) VisitGetCoursesCourseIdLessonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCoursesCourseIdLessons500JSONResponse Error
+
+func (response GetCoursesCourseIdLessons500JSONResponse) VisitGetCoursesCourseIdLessonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCoursesCourseIdLessonsOrderRequestObject struct {
+	CourseId CourseId `json:"course_id"`
+	Body     *PutCoursesCourseIdLessonsOrderJSONRequestBody
+}
 
+type PutCoursesCourseIdLessonsOrderResponseObject interface {
+	VisitPutCoursesCourseIdLessonsOrderResponse(w http.ResponseWriter) error
+}
+
+type PutCoursesCourseIdLessonsOrder200JSONResponse []Lesson
+
+func (response PutCoursesCourseIdLessonsOrder200JSONResponse) VisitPutCoursesCourseIdLessonsOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCoursesCourseIdLessonsOrder400JSONResponse Error
+
+func (response PutCoursesCourseIdLessonsOrder400JSONResponse) VisitPutCoursesCourseIdLessonsOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCoursesCourseIdLessonsOrder401JSONResponse Error
+
+func (response PutCoursesCourseIdLessonsOrder401JSONResponse) VisitPutCoursesCourseIdLessonsOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCoursesCourseIdLessonsOrder403JSONResponse Error
+
+func (response PutCoursesCourseIdLessonsOrder403JSONResponse) VisitPutCoursesCourseIdLessonsOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCoursesCourseIdLessonsOrder404JSONResponse Error
+
+func (response PutCoursesCourseIdLessonsOrder404JSONResponse) VisitPutCoursesCourseIdLessonsOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCoursesCourseIdLessonsOrder500JSONResponse Error
+
+func (response PutCoursesCourseIdLessonsOrder500JSONResponse) VisitPutCoursesCourseIdLessonsOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type GetLessonsRequestObject struct {
@@ -165,112 +477,637 @@ func (response GetLessons500JSONResponse) VisitGetLessonsResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostLessonsCreaterIdRequestObject struct {
-	CreaterId CreaterId `json:"creater_id"`
-	Body      *PostLessonsCreaterIdJSONRequestBody
+type PostLessonsRequestObject struct {
+	Body *PostLessonsJSONRequestBody
 }
 
-type PostLessonsCreaterIdResponseObject interface {
-	VisitPostLessonsCreaterIdResponse(w http.ResponseWriter) error
+type PostLessonsResponseObject interface {
+	VisitPostLessonsResponse(w http.ResponseWriter) error
 }
 
-type PostLessonsCreaterId200JSONResponse Error
+type PostLessons201JSONResponse Lesson
 
-func (response PostLessonsCreaterId200JSONResponse) VisitPostLessonsCreaterIdResponse(w http.ResponseWriter) error {
+func (response PostLessons201JSONResponse) VisitPostLessonsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostLessonsCreaterId401JSONResponse Error
+type PostLessons400JSONResponse Error
 
-func (response PostLessonsCreaterId401JSONResponse) VisitPostLessonsCreaterIdResponse(w http.ResponseWriter) error {
+func (response PostLessons400JSONResponse) VisitPostLessonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessons401JSONResponse Error
+
+func (response PostLessons401JSONResponse) VisitPostLessonsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostLessonsCreaterId500JSONResponse Error
+type PostLessons403JSONResponse Error
+
+func (response PostLessons403JSONResponse) VisitPostLessonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessons404JSONResponse Error
+
+func (response PostLessons404JSONResponse) VisitPostLessonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessons500JSONResponse Error
 
-func (response PostLessonsCreaterId500JSONResponse) VisitPostLessonsCreaterIdResponse(w http.ResponseWriter) error {
+func (response PostLessons500JSONResponse) VisitPostLessonsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get lessons
-	// (GET /lessons)
-	GetLessons(ctx context.Context, request GetLessonsRequestObject) (GetLessonsResponseObject, error)
-	// Create lesson
-	// (POST /lessons/{creater_id})
-	PostLessonsCreaterId(ctx context.Context, request PostLessonsCreaterIdRequestObject) (PostLessonsCreaterIdResponseObject, error)
+type DeleteLessonsLessonIdRequestObject struct {
+	LessonId LessonId `json:"lesson_id"`
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+type DeleteLessonsLessonIdResponseObject interface {
+	VisitDeleteLessonsLessonIdResponse(w http.ResponseWriter) error
+}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+type DeleteLessonsLessonId200JSONResponse Error
+
+func (response DeleteLessonsLessonId200JSONResponse) VisitDeleteLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+type DeleteLessonsLessonId401JSONResponse Error
+
+func (response DeleteLessonsLessonId401JSONResponse) VisitDeleteLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetLessons operation middleware
-func (sh *strictHandler) GetLessons(ctx echo.Context) error {
-	var request GetLessonsRequestObject
+type DeleteLessonsLessonId403JSONResponse Error
 
-	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLessons(ctx.Request().Context(), request.(GetLessonsRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLessons")
-	}
+func (response DeleteLessonsLessonId403JSONResponse) VisitDeleteLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	response, err := handler(ctx, request)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	if err != nil {
-		return err
-	} else if validResponse, ok := response.(GetLessonsResponseObject); ok {
-		return validResponse.VisitGetLessonsResponse(ctx.Response())
-	} else if response != nil {
-		return fmt.Errorf("unexpected response type: %T", response)
-	}
-	return nil
+type DeleteLessonsLessonId404JSONResponse Error
+
+func (response DeleteLessonsLessonId404JSONResponse) VisitDeleteLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostLessonsCreaterId operation middleware
-func (sh *strictHandler) PostLessonsCreaterId(ctx echo.Context, createrId CreaterId) error {
-	var request PostLessonsCreaterIdRequestObject
+type DeleteLessonsLessonId500JSONResponse Error
 
-	request.CreaterId = createrId
+func (response DeleteLessonsLessonId500JSONResponse) VisitDeleteLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	var body PostLessonsCreaterIdJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchLessonsLessonIdRequestObject struct {
+	LessonId LessonId `json:"lesson_id"`
+	Body     *PatchLessonsLessonIdJSONRequestBody
+}
+
+type PatchLessonsLessonIdResponseObject interface {
+	VisitPatchLessonsLessonIdResponse(w http.ResponseWriter) error
+}
+
+type PatchLessonsLessonId200JSONResponse Lesson
+
+func (response PatchLessonsLessonId200JSONResponse) VisitPatchLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchLessonsLessonId400JSONResponse Error
+
+func (response PatchLessonsLessonId400JSONResponse) VisitPatchLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchLessonsLessonId401JSONResponse Error
+
+func (response PatchLessonsLessonId401JSONResponse) VisitPatchLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchLessonsLessonId403JSONResponse Error
+
+func (response PatchLessonsLessonId403JSONResponse) VisitPatchLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchLessonsLessonId404JSONResponse Error
+
+func (response PatchLessonsLessonId404JSONResponse) VisitPatchLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchLessonsLessonId500JSONResponse Error
+
+func (response PatchLessonsLessonId500JSONResponse) VisitPatchLessonsLessonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdArchiveRequestObject struct {
+	LessonId LessonId `json:"lesson_id"`
+}
+
+type PostLessonsLessonIdArchiveResponseObject interface {
+	VisitPostLessonsLessonIdArchiveResponse(w http.ResponseWriter) error
+}
+
+type PostLessonsLessonIdArchive200JSONResponse Lesson
+
+func (response PostLessonsLessonIdArchive200JSONResponse) VisitPostLessonsLessonIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdArchive401JSONResponse Error
+
+func (response PostLessonsLessonIdArchive401JSONResponse) VisitPostLessonsLessonIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdArchive403JSONResponse Error
+
+func (response PostLessonsLessonIdArchive403JSONResponse) VisitPostLessonsLessonIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdArchive404JSONResponse Error
+
+func (response PostLessonsLessonIdArchive404JSONResponse) VisitPostLessonsLessonIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdArchive409JSONResponse Error
+
+func (response PostLessonsLessonIdArchive409JSONResponse) VisitPostLessonsLessonIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdArchive500JSONResponse Error
+
+func (response PostLessonsLessonIdArchive500JSONResponse) VisitPostLessonsLessonIdArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdCompleteRequestObject struct {
+	LessonId LessonId `json:"lesson_id"`
+}
+
+type PostLessonsLessonIdCompleteResponseObject interface {
+	VisitPostLessonsLessonIdCompleteResponse(w http.ResponseWriter) error
+}
+
+type PostLessonsLessonIdComplete200JSONResponse LessonCompletion
+
+func (response PostLessonsLessonIdComplete200JSONResponse) VisitPostLessonsLessonIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdComplete401JSONResponse Error
+
+func (response PostLessonsLessonIdComplete401JSONResponse) VisitPostLessonsLessonIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdComplete403JSONResponse Error
+
+func (response PostLessonsLessonIdComplete403JSONResponse) VisitPostLessonsLessonIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdComplete404JSONResponse Error
+
+func (response PostLessonsLessonIdComplete404JSONResponse) VisitPostLessonsLessonIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdComplete500JSONResponse Error
+
+func (response PostLessonsLessonIdComplete500JSONResponse) VisitPostLessonsLessonIdCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdPublishRequestObject struct {
+	LessonId LessonId `json:"lesson_id"`
+}
+
+type PostLessonsLessonIdPublishResponseObject interface {
+	VisitPostLessonsLessonIdPublishResponse(w http.ResponseWriter) error
+}
+
+type PostLessonsLessonIdPublish200JSONResponse Lesson
+
+func (response PostLessonsLessonIdPublish200JSONResponse) VisitPostLessonsLessonIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdPublish401JSONResponse Error
+
+func (response PostLessonsLessonIdPublish401JSONResponse) VisitPostLessonsLessonIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdPublish403JSONResponse Error
+
+func (response PostLessonsLessonIdPublish403JSONResponse) VisitPostLessonsLessonIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdPublish404JSONResponse Error
+
+func (response PostLessonsLessonIdPublish404JSONResponse) VisitPostLessonsLessonIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdPublish409JSONResponse Error
+
+func (response PostLessonsLessonIdPublish409JSONResponse) VisitPostLessonsLessonIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLessonsLessonIdPublish500JSONResponse Error
+
+func (response PostLessonsLessonIdPublish500JSONResponse) VisitPostLessonsLessonIdPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the lessons of a course in author-defined order
+	// (GET /courses/{course_id}/lessons)
+	GetCoursesCourseIdLessons(ctx context.Context, request GetCoursesCourseIdLessonsRequestObject) (GetCoursesCourseIdLessonsResponseObject, error)
+	// Reorder the lessons of a course (owning tutor or admin)
+	// (PUT /courses/{course_id}/lessons/order)
+	PutCoursesCourseIdLessonsOrder(ctx context.Context, request PutCoursesCourseIdLessonsOrderRequestObject) (PutCoursesCourseIdLessonsOrderResponseObject, error)
+	// Get lessons
+	// (GET /lessons)
+	GetLessons(ctx context.Context, request GetLessonsRequestObject) (GetLessonsResponseObject, error)
+	// Create a lesson in a course (owning tutor or admin)
+	// (POST /lessons)
+	PostLessons(ctx context.Context, request PostLessonsRequestObject) (PostLessonsResponseObject, error)
+	// Delete a lesson (owning tutor or admin)
+	// (DELETE /lessons/{lesson_id})
+	DeleteLessonsLessonId(ctx context.Context, request DeleteLessonsLessonIdRequestObject) (DeleteLessonsLessonIdResponseObject, error)
+	// Update a lesson (owning tutor or admin)
+	// (PATCH /lessons/{lesson_id})
+	PatchLessonsLessonId(ctx context.Context, request PatchLessonsLessonIdRequestObject) (PatchLessonsLessonIdResponseObject, error)
+	// Archive a published lesson (owning tutor or admin)
+	// (POST /lessons/{lesson_id}/archive)
+	PostLessonsLessonIdArchive(ctx context.Context, request PostLessonsLessonIdArchiveRequestObject) (PostLessonsLessonIdArchiveResponseObject, error)
+	// Mark a lesson as completed by the current student
+	// (POST /lessons/{lesson_id}/complete)
+	PostLessonsLessonIdComplete(ctx context.Context, request PostLessonsLessonIdCompleteRequestObject) (PostLessonsLessonIdCompleteResponseObject, error)
+	// Publish a draft or archived lesson (owning tutor or admin)
+	// (POST /lessons/{lesson_id}/publish)
+	PostLessonsLessonIdPublish(ctx context.Context, request PostLessonsLessonIdPublishRequestObject) (PostLessonsLessonIdPublishResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetCoursesCourseIdLessons operation middleware
+func (sh *strictHandler) GetCoursesCourseIdLessons(ctx echo.Context, courseId CourseId) error {
+	var request GetCoursesCourseIdLessonsRequestObject
+
+	request.CourseId = courseId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCoursesCourseIdLessons(ctx.Request().Context(), request.(GetCoursesCourseIdLessonsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCoursesCourseIdLessons")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCoursesCourseIdLessonsResponseObject); ok {
+		return validResponse.VisitGetCoursesCourseIdLessonsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutCoursesCourseIdLessonsOrder operation middleware
+func (sh *strictHandler) PutCoursesCourseIdLessonsOrder(ctx echo.Context, courseId CourseId) error {
+	var request PutCoursesCourseIdLessonsOrderRequestObject
+
+	request.CourseId = courseId
+
+	var body PutCoursesCourseIdLessonsOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCoursesCourseIdLessonsOrder(ctx.Request().Context(), request.(PutCoursesCourseIdLessonsOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCoursesCourseIdLessonsOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutCoursesCourseIdLessonsOrderResponseObject); ok {
+		return validResponse.VisitPutCoursesCourseIdLessonsOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetLessons operation middleware
+func (sh *strictHandler) GetLessons(ctx echo.Context) error {
+	var request GetLessonsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLessons(ctx.Request().Context(), request.(GetLessonsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLessons")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetLessonsResponseObject); ok {
+		return validResponse.VisitGetLessonsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLessons operation middleware
+func (sh *strictHandler) PostLessons(ctx echo.Context) error {
+	var request PostLessonsRequestObject
+
+	var body PostLessonsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLessons(ctx.Request().Context(), request.(PostLessonsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLessons")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLessonsResponseObject); ok {
+		return validResponse.VisitPostLessonsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteLessonsLessonId operation middleware
+func (sh *strictHandler) DeleteLessonsLessonId(ctx echo.Context, lessonId LessonId) error {
+	var request DeleteLessonsLessonIdRequestObject
+
+	request.LessonId = lessonId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLessonsLessonId(ctx.Request().Context(), request.(DeleteLessonsLessonIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLessonsLessonId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteLessonsLessonIdResponseObject); ok {
+		return validResponse.VisitDeleteLessonsLessonIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchLessonsLessonId operation middleware
+func (sh *strictHandler) PatchLessonsLessonId(ctx echo.Context, lessonId LessonId) error {
+	var request PatchLessonsLessonIdRequestObject
+
+	request.LessonId = lessonId
+
+	var body PatchLessonsLessonIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchLessonsLessonId(ctx.Request().Context(), request.(PatchLessonsLessonIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchLessonsLessonId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchLessonsLessonIdResponseObject); ok {
+		return validResponse.VisitPatchLessonsLessonIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLessonsLessonIdArchive operation middleware
+func (sh *strictHandler) PostLessonsLessonIdArchive(ctx echo.Context, lessonId LessonId) error {
+	var request PostLessonsLessonIdArchiveRequestObject
+
+	request.LessonId = lessonId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLessonsLessonIdArchive(ctx.Request().Context(), request.(PostLessonsLessonIdArchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLessonsLessonIdArchive")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLessonsLessonIdArchiveResponseObject); ok {
+		return validResponse.VisitPostLessonsLessonIdArchiveResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLessonsLessonIdComplete operation middleware
+func (sh *strictHandler) PostLessonsLessonIdComplete(ctx echo.Context, lessonId LessonId) error {
+	var request PostLessonsLessonIdCompleteRequestObject
+
+	request.LessonId = lessonId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLessonsLessonIdComplete(ctx.Request().Context(), request.(PostLessonsLessonIdCompleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLessonsLessonIdComplete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLessonsLessonIdCompleteResponseObject); ok {
+		return validResponse.VisitPostLessonsLessonIdCompleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLessonsLessonIdPublish operation middleware
+func (sh *strictHandler) PostLessonsLessonIdPublish(ctx echo.Context, lessonId LessonId) error {
+	var request PostLessonsLessonIdPublishRequestObject
+
+	request.LessonId = lessonId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLessonsCreaterId(ctx.Request().Context(), request.(PostLessonsCreaterIdRequestObject))
+		return sh.ssi.PostLessonsLessonIdPublish(ctx.Request().Context(), request.(PostLessonsLessonIdPublishRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLessonsCreaterId")
+		handler = middleware(handler, "PostLessonsLessonIdPublish")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostLessonsCreaterIdResponseObject); ok {
-		return validResponse.VisitPostLessonsCreaterIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostLessonsLessonIdPublishResponseObject); ok {
+		return validResponse.VisitPostLessonsLessonIdPublishResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -0,0 +1,268 @@
+// Package sessions provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package sessions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Code    *int    `json:"code,omitempty"`
+	Details *string `json:"details,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current Whether this is the session of the request
+	Current bool `json:"current"`
+
+	// Device Browser and platform derived from the user agent
+	Device     string             `json:"device"`
+	Id         openapi_types.UUID `json:"id"`
+	IpAddress  string             `json:"ip_address"`
+	LastUsedAt time.Time          `json:"last_used_at"`
+	UserAgent  string             `json:"user_agent"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the devices the current user is logged in on
+	// (GET /users/me/sessions)
+	GetUsersMeSessions(ctx echo.Context) error
+	// Log out one session, its access and refresh tokens stop working
+	// (DELETE /users/me/sessions/{session_id})
+	DeleteUsersMeSessionsSessionId(ctx echo.Context, sessionId openapi_types.UUID) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetUsersMeSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersMeSessions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersMeSessions(ctx)
+	return err
+}
+
+// DeleteUsersMeSessionsSessionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUsersMeSessionsSessionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session_id" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session_id", runtime.ParamLocationPath, ctx.Param("session_id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUsersMeSessionsSessionId(ctx, sessionId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/users/me/sessions", wrapper.GetUsersMeSessions)
+	router.DELETE(baseURL+"/users/me/sessions/:session_id", wrapper.DeleteUsersMeSessionsSessionId)
+
+}
+
+type GetUsersMeSessionsRequestObject struct {
+}
+
+type GetUsersMeSessionsResponseObject interface {
+	VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersMeSessions200JSONResponse []Session
+
+func (response GetUsersMeSessions200JSONResponse) VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeSessions401JSONResponse Error
+
+func (response GetUsersMeSessions401JSONResponse) VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeSessions500JSONResponse Error
+
+func (response GetUsersMeSessions500JSONResponse) VisitGetUsersMeSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersMeSessionsSessionIdRequestObject struct {
+	SessionId openapi_types.UUID `json:"session_id"`
+}
+
+type DeleteUsersMeSessionsSessionIdResponseObject interface {
+	VisitDeleteUsersMeSessionsSessionIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersMeSessionsSessionId200JSONResponse Error
+
+func (response DeleteUsersMeSessionsSessionId200JSONResponse) VisitDeleteUsersMeSessionsSessionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersMeSessionsSessionId401JSONResponse Error
+
+func (response DeleteUsersMeSessionsSessionId401JSONResponse) VisitDeleteUsersMeSessionsSessionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersMeSessionsSessionId404JSONResponse Error
+
+func (response DeleteUsersMeSessionsSessionId404JSONResponse) VisitDeleteUsersMeSessionsSessionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersMeSessionsSessionId500JSONResponse Error
+
+func (response DeleteUsersMeSessionsSessionId500JSONResponse) VisitDeleteUsersMeSessionsSessionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List the devices the current user is logged in on
+	// (GET /users/me/sessions)
+	GetUsersMeSessions(ctx context.Context, request GetUsersMeSessionsRequestObject) (GetUsersMeSessionsResponseObject, error)
+	// Log out one session, its access and refresh tokens stop working
+	// (DELETE /users/me/sessions/{session_id})
+	DeleteUsersMeSessionsSessionId(ctx context.Context, request DeleteUsersMeSessionsSessionIdRequestObject) (DeleteUsersMeSessionsSessionIdResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetUsersMeSessions operation middleware
+func (sh *strictHandler) GetUsersMeSessions(ctx echo.Context) error {
+	var request GetUsersMeSessionsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMeSessions(ctx.Request().Context(), request.(GetUsersMeSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMeSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersMeSessionsResponseObject); ok {
+		return validResponse.VisitGetUsersMeSessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteUsersMeSessionsSessionId operation middleware
+func (sh *strictHandler) DeleteUsersMeSessionsSessionId(ctx echo.Context, sessionId openapi_types.UUID) error {
+	var request DeleteUsersMeSessionsSessionIdRequestObject
+
+	request.SessionId = sessionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersMeSessionsSessionId(ctx.Request().Context(), request.(DeleteUsersMeSessionsSessionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersMeSessionsSessionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteUsersMeSessionsSessionIdResponseObject); ok {
+		return validResponse.VisitDeleteUsersMeSessionsSessionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -0,0 +1,406 @@
+// Package twofactor provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package twofactor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// DisableTwoFactorRequest defines model for DisableTwoFactorRequest.
+type DisableTwoFactorRequest struct {
+	// Code 6 digit TOTP code or a recovery code
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code    *int    `json:"code,omitempty"`
+	Details *string `json:"details,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorCodeRequest defines model for TwoFactorCodeRequest.
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	// OtpauthUri otpauth:// URI, usually shown as a QR code
+	OtpauthUri string `json:"otpauth_uri"`
+
+	// Secret Base32 secret for manual entry
+	Secret string `json:"secret"`
+}
+
+// PostAuth2faConfirmJSONRequestBody defines body for PostAuth2faConfirm for application/json ContentType.
+type PostAuth2faConfirmJSONRequestBody = TwoFactorCodeRequest
+
+// PostAuth2faDisableJSONRequestBody defines body for PostAuth2faDisable for application/json ContentType.
+type PostAuth2faDisableJSONRequestBody = DisableTwoFactorRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Enable two-factor authentication with a first code
+	// (POST /auth/2fa/confirm)
+	PostAuth2faConfirm(ctx echo.Context) error
+	// Disable two-factor authentication
+	// (POST /auth/2fa/disable)
+	PostAuth2faDisable(ctx echo.Context) error
+	// Start two-factor setup with a new TOTP secret
+	// (POST /auth/2fa/enroll)
+	PostAuth2faEnroll(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// PostAuth2faConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuth2faConfirm(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuth2faConfirm(ctx)
+	return err
+}
+
+// PostAuth2faDisable converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuth2faDisable(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuth2faDisable(ctx)
+	return err
+}
+
+// PostAuth2faEnroll converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuth2faEnroll(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuth2faEnroll(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/auth/2fa/confirm", wrapper.PostAuth2faConfirm)
+	router.POST(baseURL+"/auth/2fa/disable", wrapper.PostAuth2faDisable)
+	router.POST(baseURL+"/auth/2fa/enroll", wrapper.PostAuth2faEnroll)
+
+}
+
+type PostAuth2faConfirmRequestObject struct {
+	Body *PostAuth2faConfirmJSONRequestBody
+}
+
+type PostAuth2faConfirmResponseObject interface {
+	VisitPostAuth2faConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostAuth2faConfirm200JSONResponse RecoveryCodes
+
+func (response PostAuth2faConfirm200JSONResponse) VisitPostAuth2faConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faConfirm400JSONResponse Error
+
+func (response PostAuth2faConfirm400JSONResponse) VisitPostAuth2faConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faConfirm401JSONResponse Error
+
+func (response PostAuth2faConfirm401JSONResponse) VisitPostAuth2faConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faConfirm409JSONResponse Error
+
+func (response PostAuth2faConfirm409JSONResponse) VisitPostAuth2faConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faConfirm500JSONResponse Error
+
+func (response PostAuth2faConfirm500JSONResponse) VisitPostAuth2faConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faDisableRequestObject struct {
+	Body *PostAuth2faDisableJSONRequestBody
+}
+
+type PostAuth2faDisableResponseObject interface {
+	VisitPostAuth2faDisableResponse(w http.ResponseWriter) error
+}
+
+type PostAuth2faDisable200JSONResponse Error
+
+func (response PostAuth2faDisable200JSONResponse) VisitPostAuth2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faDisable400JSONResponse Error
+
+func (response PostAuth2faDisable400JSONResponse) VisitPostAuth2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faDisable401JSONResponse Error
+
+func (response PostAuth2faDisable401JSONResponse) VisitPostAuth2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faDisable409JSONResponse Error
+
+func (response PostAuth2faDisable409JSONResponse) VisitPostAuth2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faDisable500JSONResponse Error
+
+func (response PostAuth2faDisable500JSONResponse) VisitPostAuth2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faEnrollRequestObject struct {
+}
+
+type PostAuth2faEnrollResponseObject interface {
+	VisitPostAuth2faEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostAuth2faEnroll200JSONResponse TwoFactorEnrollment
+
+func (response PostAuth2faEnroll200JSONResponse) VisitPostAuth2faEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faEnroll401JSONResponse Error
+
+func (response PostAuth2faEnroll401JSONResponse) VisitPostAuth2faEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faEnroll403JSONResponse Error
+
+func (response PostAuth2faEnroll403JSONResponse) VisitPostAuth2faEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faEnroll409JSONResponse Error
+
+func (response PostAuth2faEnroll409JSONResponse) VisitPostAuth2faEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuth2faEnroll500JSONResponse Error
+
+func (response PostAuth2faEnroll500JSONResponse) VisitPostAuth2faEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Enable two-factor authentication with a first code
+	// (POST /auth/2fa/confirm)
+	PostAuth2faConfirm(ctx context.Context, request PostAuth2faConfirmRequestObject) (PostAuth2faConfirmResponseObject, error)
+	// Disable two-factor authentication
+	// (POST /auth/2fa/disable)
+	PostAuth2faDisable(ctx context.Context, request PostAuth2faDisableRequestObject) (PostAuth2faDisableResponseObject, error)
+	// Start two-factor setup with a new TOTP secret
+	// (POST /auth/2fa/enroll)
+	PostAuth2faEnroll(ctx context.Context, request PostAuth2faEnrollRequestObject) (PostAuth2faEnrollResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostAuth2faConfirm operation middleware
+func (sh *strictHandler) PostAuth2faConfirm(ctx echo.Context) error {
+	var request PostAuth2faConfirmRequestObject
+
+	var body PostAuth2faConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuth2faConfirm(ctx.Request().Context(), request.(PostAuth2faConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuth2faConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuth2faConfirmResponseObject); ok {
+		return validResponse.VisitPostAuth2faConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuth2faDisable operation middleware
+func (sh *strictHandler) PostAuth2faDisable(ctx echo.Context) error {
+	var request PostAuth2faDisableRequestObject
+
+	var body PostAuth2faDisableJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuth2faDisable(ctx.Request().Context(), request.(PostAuth2faDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuth2faDisable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuth2faDisableResponseObject); ok {
+		return validResponse.VisitPostAuth2faDisableResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuth2faEnroll operation middleware
+func (sh *strictHandler) PostAuth2faEnroll(ctx echo.Context) error {
+	var request PostAuth2faEnrollRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuth2faEnroll(ctx.Request().Context(), request.(PostAuth2faEnrollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuth2faEnroll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuth2faEnrollResponseObject); ok {
+		return validResponse.VisitPostAuth2faEnrollResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
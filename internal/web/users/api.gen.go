@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"time"
 
@@ -20,6 +21,26 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for XPLedgerEntryEventType.
+const (
+	CourseCompleted XPLedgerEntryEventType = "course_completed"
+	LessonCompleted XPLedgerEntryEventType = "lesson_completed"
+)
+
+// Avatar defines model for Avatar.
+type Avatar struct {
+	// Url URL of the large variant, also returned as the avatar of the profile
+	Url *string `json:"url,omitempty"`
+
+	// Variants URLs by variant name (large, medium, small)
+	Variants *map[string]string `json:"variants,omitempty"`
+}
+
+// AvatarUpload defines model for AvatarUpload.
+type AvatarUpload struct {
+	File openapi_types.File `json:"file"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    *int    `json:"code,omitempty"`
@@ -27,6 +48,35 @@ type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// Follow defines model for Follow.
+type Follow struct {
+	CreatedAt  *time.Time          `json:"created_at,omitempty"`
+	FolloweeId *openapi_types.UUID `json:"followee_id,omitempty"`
+	FollowerId *openapi_types.UUID `json:"follower_id,omitempty"`
+}
+
+// FollowUser defines model for FollowUser.
+type FollowUser struct {
+	Avatar     *string             `json:"avatar,omitempty"`
+	FirstName  *string             `json:"first_name,omitempty"`
+	FollowedAt *time.Time          `json:"followed_at,omitempty"`
+	LastName   *string             `json:"last_name,omitempty"`
+	UserId     *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// FollowsPage defines model for FollowsPage.
+type FollowsPage struct {
+	Pagination *Pagination   `json:"pagination,omitempty"`
+	Users      *[]FollowUser `json:"users,omitempty"`
+}
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	Limit *int `json:"limit,omitempty"`
+	Page  *int `json:"page,omitempty"`
+	Total *int `json:"total,omitempty"`
+}
+
 // UpdateStudentStatus defines model for UpdateStudentStatus.
 type UpdateStudentStatus struct {
 	IsActive   *bool `json:"is_active,omitempty"`
@@ -44,16 +94,26 @@ type UpdateUserInfo struct {
 
 // UserAchievement defines model for UserAchievement.
 type UserAchievement struct {
+	// AchievementId Definition of the catalog the achievement was granted from
+	AchievementId   *openapi_types.UUID `json:"achievement_id,omitempty"`
 	AchievementName *string             `json:"achievement_name,omitempty"`
+	CreatedAt       *time.Time          `json:"created_at,omitempty"`
+	Description     *string             `json:"description,omitempty"`
+	Icon            *string             `json:"icon,omitempty"`
 	Id              *openapi_types.UUID `json:"id,omitempty"`
 	UserId          *openapi_types.UUID `json:"user_id,omitempty"`
 }
 
 // UserBadge defines model for UserBadge.
 type UserBadge struct {
-	BadgeName *string             `json:"badge_name,omitempty"`
-	Id        *openapi_types.UUID `json:"id,omitempty"`
-	UserId    *openapi_types.UUID `json:"user_id,omitempty"`
+	// BadgeId Definition of the catalog the badge was granted from
+	BadgeId     *openapi_types.UUID `json:"badge_id,omitempty"`
+	BadgeName   *string             `json:"badge_name,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Icon        *string             `json:"icon,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	UserId      *openapi_types.UUID `json:"user_id,omitempty"`
 }
 
 // UserProfile defines model for UserProfile.
@@ -85,6 +145,26 @@ type UserStats struct {
 	Xp                *int                `json:"xp,omitempty"`
 }
 
+// XPLedgerEntry defines model for XPLedgerEntry.
+type XPLedgerEntry struct {
+	CreatedAt *time.Time              `json:"created_at,omitempty"`
+	EventType *XPLedgerEntryEventType `json:"event_type,omitempty"`
+	Id        *openapi_types.UUID     `json:"id,omitempty"`
+	Points    *int                    `json:"points,omitempty"`
+
+	// SubjectId Lesson or course the XP was awarded for
+	SubjectId *openapi_types.UUID `json:"subject_id,omitempty"`
+}
+
+// XPLedgerEntryEventType defines model for XPLedgerEntry.EventType.
+type XPLedgerEntryEventType string
+
+// XPLedgerPage defines model for XPLedgerPage.
+type XPLedgerPage struct {
+	Entries    *[]XPLedgerEntry `json:"entries,omitempty"`
+	Pagination *Pagination      `json:"pagination,omitempty"`
+}
+
 // ReplacerId defines model for ReplacerId.
 type ReplacerId = openapi_types.UUID
 
@@ -94,12 +174,57 @@ type StudentId = openapi_types.UUID
 // UserId defines model for UserId.
 type UserId = openapi_types.UUID
 
+// PutUsersMePasswordJSONBody defines parameters for PutUsersMePassword.
+type PutUsersMePasswordJSONBody struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
 // PutUsersProfilePasswordIdJSONBody defines parameters for PutUsersProfilePasswordId.
 type PutUsersProfilePasswordIdJSONBody struct {
 	CurrentPassword string `json:"current_password"`
 	NewPassword     string `json:"new_password"`
 }
 
+// GetUsersIdFollowersParams defines parameters for GetUsersIdFollowers.
+type GetUsersIdFollowersParams struct {
+	// Page Page number, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of users per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersIdFollowingParams defines parameters for GetUsersIdFollowing.
+type GetUsersIdFollowingParams struct {
+	// Page Page number, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of users per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersIdXpParams defines parameters for GetUsersIdXp.
+type GetUsersIdXpParams struct {
+	// Page Page number, starting from 1
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of entries per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchUsersMeJSONRequestBody defines body for PatchUsersMe for application/json ContentType.
+type PatchUsersMeJSONRequestBody = UpdateUserInfo
+
+// PutUsersMeAvatarMultipartRequestBody defines body for PutUsersMeAvatar for multipart/form-data ContentType.
+type PutUsersMeAvatarMultipartRequestBody = AvatarUpload
+
+// PutUsersMePasswordJSONRequestBody defines body for PutUsersMePassword for application/json ContentType.
+type PutUsersMePasswordJSONRequestBody PutUsersMePasswordJSONBody
+
+// PutUsersProfileAvatarIdMultipartRequestBody defines body for PutUsersProfileAvatarId for multipart/form-data ContentType.
+type PutUsersProfileAvatarIdMultipartRequestBody = AvatarUpload
+
 // PutUsersProfilePasswordIdJSONRequestBody defines body for PutUsersProfilePasswordId for application/json ContentType.
 type PutUsersProfilePasswordIdJSONRequestBody PutUsersProfilePasswordIdJSONBody
 
@@ -111,6 +236,30 @@ type PutUsersReplacerIdStudentsStudentIdStatusJSONRequestBody = UpdateStudentSta
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the profile of the current user
+	// (GET /users/me)
+	GetUsersMe(ctx echo.Context) error
+	// Update the profile of the current user
+	// (PATCH /users/me)
+	PatchUsersMe(ctx echo.Context) error
+	// Get the achievements of the current user
+	// (GET /users/me/achievements)
+	GetUsersMeAchievements(ctx echo.Context) error
+	// Upload the avatar of the current user
+	// (PUT /users/me/avatar)
+	PutUsersMeAvatar(ctx echo.Context) error
+	// Get the badges of the current user
+	// (GET /users/me/badges)
+	GetUsersMeBadges(ctx echo.Context) error
+	// Change the password of the current user
+	// (PUT /users/me/password)
+	PutUsersMePassword(ctx echo.Context) error
+	// Get the statistics of the current user
+	// (GET /users/me/stats)
+	GetUsersMeStats(ctx echo.Context) error
+	// Upload a user avatar
+	// (PUT /users/profile/avatar/{id})
+	PutUsersProfileAvatarId(ctx echo.Context, id UserId) error
 	// Update user password
 	// (PUT /users/profile/password/{id})
 	PutUsersProfilePasswordId(ctx echo.Context, id UserId) error
@@ -126,9 +275,24 @@ type ServerInterface interface {
 	// Get user badges
 	// (GET /users/{id}/badges)
 	GetUsersIdBadges(ctx echo.Context, id UserId) error
+	// Unfollow a user
+	// (DELETE /users/{id}/follow)
+	DeleteUsersIdFollow(ctx echo.Context, id UserId) error
+	// Follow a user
+	// (POST /users/{id}/follow)
+	PostUsersIdFollow(ctx echo.Context, id UserId) error
+	// List the followers of a user
+	// (GET /users/{id}/followers)
+	GetUsersIdFollowers(ctx echo.Context, id UserId, params GetUsersIdFollowersParams) error
+	// List the users a user follows
+	// (GET /users/{id}/following)
+	GetUsersIdFollowing(ctx echo.Context, id UserId, params GetUsersIdFollowingParams) error
 	// Get user statistics
 	// (GET /users/{id}/stats)
 	GetUsersIdStats(ctx echo.Context, id UserId) error
+	// List the XP history of a user
+	// (GET /users/{id}/xp)
+	GetUsersIdXp(ctx echo.Context, id UserId, params GetUsersIdXpParams) error
 	// Update student status
 	// (PUT /users/{replacer_id}/students/{student_id}/status)
 	PutUsersReplacerIdStudentsStudentIdStatus(ctx echo.Context, replacerId ReplacerId, studentId StudentId) error
@@ -139,6 +303,101 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetUsersMe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersMe(ctx)
+	return err
+}
+
+// PatchUsersMe converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchUsersMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchUsersMe(ctx)
+	return err
+}
+
+// GetUsersMeAchievements converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersMeAchievements(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersMeAchievements(ctx)
+	return err
+}
+
+// PutUsersMeAvatar converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUsersMeAvatar(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutUsersMeAvatar(ctx)
+	return err
+}
+
+// GetUsersMeBadges converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersMeBadges(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersMeBadges(ctx)
+	return err
+}
+
+// PutUsersMePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUsersMePassword(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutUsersMePassword(ctx)
+	return err
+}
+
+// GetUsersMeStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersMeStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersMeStats(ctx)
+	return err
+}
+
+// PutUsersProfileAvatarId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUsersProfileAvatarId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutUsersProfileAvatarId(ctx, id)
+	return err
+}
+
 // PutUsersProfilePasswordId converts echo context to params.
 func (w *ServerInterfaceWrapper) PutUsersProfilePasswordId(ctx echo.Context) error {
 	var err error
@@ -186,6 +445,8 @@ func (w *ServerInterfaceWrapper) PatchUsersProfileId(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PatchUsersProfileId(ctx, id)
 	return err
@@ -227,6 +488,110 @@ func (w *ServerInterfaceWrapper) GetUsersIdBadges(ctx echo.Context) error {
 	return err
 }
 
+// DeleteUsersIdFollow converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUsersIdFollow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUsersIdFollow(ctx, id)
+	return err
+}
+
+// PostUsersIdFollow converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersIdFollow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersIdFollow(ctx, id)
+	return err
+}
+
+// GetUsersIdFollowers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersIdFollowers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdFollowersParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersIdFollowers(ctx, id, params)
+	return err
+}
+
+// GetUsersIdFollowing converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersIdFollowing(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdFollowingParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersIdFollowing(ctx, id, params)
+	return err
+}
+
 // GetUsersIdStats converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUsersIdStats(ctx echo.Context) error {
 	var err error
@@ -245,6 +610,40 @@ func (w *ServerInterfaceWrapper) GetUsersIdStats(ctx echo.Context) error {
 	return err
 }
 
+// GetUsersIdXp converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersIdXp(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdXpParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersIdXp(ctx, id, params)
+	return err
+}
+
 // PutUsersReplacerIdStudentsStudentIdStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) PutUsersReplacerIdStudentsStudentIdStatus(ctx echo.Context) error {
 	var err error
@@ -299,106 +698,489 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/users/me", wrapper.GetUsersMe)
+	router.PATCH(baseURL+"/users/me", wrapper.PatchUsersMe)
+	router.GET(baseURL+"/users/me/achievements", wrapper.GetUsersMeAchievements)
+	router.PUT(baseURL+"/users/me/avatar", wrapper.PutUsersMeAvatar)
+	router.GET(baseURL+"/users/me/badges", wrapper.GetUsersMeBadges)
+	router.PUT(baseURL+"/users/me/password", wrapper.PutUsersMePassword)
+	router.GET(baseURL+"/users/me/stats", wrapper.GetUsersMeStats)
+	router.PUT(baseURL+"/users/profile/avatar/:id", wrapper.PutUsersProfileAvatarId)
 	router.PUT(baseURL+"/users/profile/password/:id", wrapper.PutUsersProfilePasswordId)
 	router.GET(baseURL+"/users/profile/:id", wrapper.GetUsersProfileId)
 	router.PATCH(baseURL+"/users/profile/:id", wrapper.PatchUsersProfileId)
 	router.GET(baseURL+"/users/:id/achievements", wrapper.GetUsersIdAchievements)
 	router.GET(baseURL+"/users/:id/badges", wrapper.GetUsersIdBadges)
+	router.DELETE(baseURL+"/users/:id/follow", wrapper.DeleteUsersIdFollow)
+	router.POST(baseURL+"/users/:id/follow", wrapper.PostUsersIdFollow)
+	router.GET(baseURL+"/users/:id/followers", wrapper.GetUsersIdFollowers)
+	router.GET(baseURL+"/users/:id/following", wrapper.GetUsersIdFollowing)
 	router.GET(baseURL+"/users/:id/stats", wrapper.GetUsersIdStats)
+	router.GET(baseURL+"/users/:id/xp", wrapper.GetUsersIdXp)
 	router.PUT(baseURL+"/users/:replacer_id/students/:student_id/status", wrapper.PutUsersReplacerIdStudentsStudentIdStatus)
 
 }
 
-type PutUsersProfilePasswordIdRequestObject struct {
-	Id   UserId `json:"id"`
-	Body *PutUsersProfilePasswordIdJSONRequestBody
+type GetUsersMeRequestObject struct {
 }
 
-type PutUsersProfilePasswordIdResponseObject interface {
-	VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error
+type GetUsersMeResponseObject interface {
+	VisitGetUsersMeResponse(w http.ResponseWriter) error
 }
 
-type PutUsersProfilePasswordId200JSONResponse struct {
-	Code    *int    `json:"code,omitempty"`
-	Message *string `json:"message,omitempty"`
-}
+type GetUsersMe200JSONResponse UserProfile
 
-func (response PutUsersProfilePasswordId200JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+func (response GetUsersMe200JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersProfilePasswordId400JSONResponse Error
-
-func (response PutUsersProfilePasswordId400JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PutUsersProfilePasswordId401JSONResponse Error
+type GetUsersMe401JSONResponse Error
 
-func (response PutUsersProfilePasswordId401JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+func (response GetUsersMe401JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersProfilePasswordId500JSONResponse Error
+type GetUsersMe500JSONResponse Error
 
-func (response PutUsersProfilePasswordId500JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+func (response GetUsersMe500JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersProfileIdRequestObject struct {
-	Id UserId `json:"id"`
+type PatchUsersMeRequestObject struct {
+	Body *PatchUsersMeJSONRequestBody
 }
 
-type GetUsersProfileIdResponseObject interface {
-	VisitGetUsersProfileIdResponse(w http.ResponseWriter) error
+type PatchUsersMeResponseObject interface {
+	VisitPatchUsersMeResponse(w http.ResponseWriter) error
 }
 
-type GetUsersProfileId200JSONResponse UserProfile
+type PatchUsersMe200JSONResponse Error
 
-func (response GetUsersProfileId200JSONResponse) VisitGetUsersProfileIdResponse(w http.ResponseWriter) error {
+func (response PatchUsersMe200JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersProfileId401JSONResponse Error
+type PatchUsersMe400JSONResponse Error
 
-func (response GetUsersProfileId401JSONResponse) VisitGetUsersProfileIdResponse(w http.ResponseWriter) error {
+func (response PatchUsersMe400JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe401JSONResponse Error
+
+func (response PatchUsersMe401JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersProfileId500JSONResponse Error
+type PatchUsersMe500JSONResponse Error
 
-func (response GetUsersProfileId500JSONResponse) VisitGetUsersProfileIdResponse(w http.ResponseWriter) error {
+func (response PatchUsersMe500JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchUsersProfileIdRequestObject struct {
-	Id   UserId `json:"id"`
-	Body *PatchUsersProfileIdJSONRequestBody
+type GetUsersMeAchievementsRequestObject struct {
 }
 
-type PatchUsersProfileIdResponseObject interface {
-	VisitPatchUsersProfileIdResponse(w http.ResponseWriter) error
+type GetUsersMeAchievementsResponseObject interface {
+	VisitGetUsersMeAchievementsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersMeAchievements200JSONResponse []UserAchievement
+
+func (response GetUsersMeAchievements200JSONResponse) VisitGetUsersMeAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeAchievements401JSONResponse Error
+
+func (response GetUsersMeAchievements401JSONResponse) VisitGetUsersMeAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeAchievements500JSONResponse Error
+
+func (response GetUsersMeAchievements500JSONResponse) VisitGetUsersMeAchievementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMeAvatarRequestObject struct {
+	Body *multipart.Reader
+}
+
+type PutUsersMeAvatarResponseObject interface {
+	VisitPutUsersMeAvatarResponse(w http.ResponseWriter) error
+}
+
+type PutUsersMeAvatar200JSONResponse Avatar
+
+func (response PutUsersMeAvatar200JSONResponse) VisitPutUsersMeAvatarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMeAvatar400JSONResponse Error
+
+func (response PutUsersMeAvatar400JSONResponse) VisitPutUsersMeAvatarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMeAvatar401JSONResponse Error
+
+func (response PutUsersMeAvatar401JSONResponse) VisitPutUsersMeAvatarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMeAvatar413JSONResponse Error
+
+func (response PutUsersMeAvatar413JSONResponse) VisitPutUsersMeAvatarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMeAvatar500JSONResponse Error
+
+func (response PutUsersMeAvatar500JSONResponse) VisitPutUsersMeAvatarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeBadgesRequestObject struct {
+}
+
+type GetUsersMeBadgesResponseObject interface {
+	VisitGetUsersMeBadgesResponse(w http.ResponseWriter) error
+}
+
+type GetUsersMeBadges200JSONResponse []UserBadge
+
+func (response GetUsersMeBadges200JSONResponse) VisitGetUsersMeBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeBadges401JSONResponse Error
+
+func (response GetUsersMeBadges401JSONResponse) VisitGetUsersMeBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeBadges500JSONResponse Error
+
+func (response GetUsersMeBadges500JSONResponse) VisitGetUsersMeBadgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMePasswordRequestObject struct {
+	Body *PutUsersMePasswordJSONRequestBody
+}
+
+type PutUsersMePasswordResponseObject interface {
+	VisitPutUsersMePasswordResponse(w http.ResponseWriter) error
+}
+
+type PutUsersMePassword200JSONResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PutUsersMePassword200JSONResponse) VisitPutUsersMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMePassword400JSONResponse Error
+
+func (response PutUsersMePassword400JSONResponse) VisitPutUsersMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMePassword401JSONResponse Error
+
+func (response PutUsersMePassword401JSONResponse) VisitPutUsersMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersMePassword500JSONResponse Error
+
+func (response PutUsersMePassword500JSONResponse) VisitPutUsersMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeStatsRequestObject struct {
+}
+
+type GetUsersMeStatsResponseObject interface {
+	VisitGetUsersMeStatsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersMeStats200JSONResponse UserStats
+
+func (response GetUsersMeStats200JSONResponse) VisitGetUsersMeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeStats401JSONResponse Error
+
+func (response GetUsersMeStats401JSONResponse) VisitGetUsersMeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeStats500JSONResponse Error
+
+func (response GetUsersMeStats500JSONResponse) VisitGetUsersMeStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfileAvatarIdRequestObject struct {
+	Id   UserId `json:"id"`
+	Body *multipart.Reader
+}
+
+type PutUsersProfileAvatarIdResponseObject interface {
+	VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error
+}
+
+type PutUsersProfileAvatarId200JSONResponse Avatar
+
+func (response PutUsersProfileAvatarId200JSONResponse) VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfileAvatarId400JSONResponse Error
+
+func (response PutUsersProfileAvatarId400JSONResponse) VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfileAvatarId401JSONResponse Error
+
+func (response PutUsersProfileAvatarId401JSONResponse) VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfileAvatarId403JSONResponse Error
+
+func (response PutUsersProfileAvatarId403JSONResponse) VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfileAvatarId404JSONResponse Error
+
+func (response PutUsersProfileAvatarId404JSONResponse) VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfileAvatarId413JSONResponse Error
+
+func (response PutUsersProfileAvatarId413JSONResponse) VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfileAvatarId500JSONResponse Error
+
+func (response PutUsersProfileAvatarId500JSONResponse) VisitPutUsersProfileAvatarIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfilePasswordIdRequestObject struct {
+	Id   UserId `json:"id"`
+	Body *PutUsersProfilePasswordIdJSONRequestBody
+}
+
+type PutUsersProfilePasswordIdResponseObject interface {
+	VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error
+}
+
+type PutUsersProfilePasswordId200JSONResponse struct {
+	Code    *int    `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PutUsersProfilePasswordId200JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfilePasswordId400JSONResponse Error
+
+func (response PutUsersProfilePasswordId400JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfilePasswordId401JSONResponse Error
+
+func (response PutUsersProfilePasswordId401JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfilePasswordId403JSONResponse Error
+
+func (response PutUsersProfilePasswordId403JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfilePasswordId404JSONResponse Error
+
+func (response PutUsersProfilePasswordId404JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersProfilePasswordId500JSONResponse Error
+
+func (response PutUsersProfilePasswordId500JSONResponse) VisitPutUsersProfilePasswordIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersProfileIdRequestObject struct {
+	Id UserId `json:"id"`
+}
+
+type GetUsersProfileIdResponseObject interface {
+	VisitGetUsersProfileIdResponse(w http.ResponseWriter) error
+}
+
+type GetUsersProfileId200JSONResponse UserProfile
+
+func (response GetUsersProfileId200JSONResponse) VisitGetUsersProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersProfileId401JSONResponse Error
+
+func (response GetUsersProfileId401JSONResponse) VisitGetUsersProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersProfileId500JSONResponse Error
+
+func (response GetUsersProfileId500JSONResponse) VisitGetUsersProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersProfileIdRequestObject struct {
+	Id   UserId `json:"id"`
+	Body *PatchUsersProfileIdJSONRequestBody
+}
+
+type PatchUsersProfileIdResponseObject interface {
+	VisitPatchUsersProfileIdResponse(w http.ResponseWriter) error
 }
 
 type PatchUsersProfileId200JSONResponse Error
@@ -419,6 +1201,24 @@ func (response PatchUsersProfileId400JSONResponse) VisitPatchUsersProfileIdRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PatchUsersProfileId401JSONResponse Error
+
+func (response PatchUsersProfileId401JSONResponse) VisitPatchUsersProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersProfileId403JSONResponse Error
+
+func (response PatchUsersProfileId403JSONResponse) VisitPatchUsersProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PatchUsersProfileId500JSONResponse Error
 
 func (response PatchUsersProfileId500JSONResponse) VisitPatchUsersProfileIdResponse(w http.ResponseWriter) error {
@@ -498,6 +1298,220 @@ func (response GetUsersIdBadges500JSONResponse) VisitGetUsersIdBadgesResponse(w
 	return json.NewEncoder(w).Encode(response)
 }
 
+type DeleteUsersIdFollowRequestObject struct {
+	Id UserId `json:"id"`
+}
+
+type DeleteUsersIdFollowResponseObject interface {
+	VisitDeleteUsersIdFollowResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersIdFollow200JSONResponse Error
+
+func (response DeleteUsersIdFollow200JSONResponse) VisitDeleteUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdFollow401JSONResponse Error
+
+func (response DeleteUsersIdFollow401JSONResponse) VisitDeleteUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdFollow404JSONResponse Error
+
+func (response DeleteUsersIdFollow404JSONResponse) VisitDeleteUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdFollow500JSONResponse Error
+
+func (response DeleteUsersIdFollow500JSONResponse) VisitDeleteUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdFollowRequestObject struct {
+	Id UserId `json:"id"`
+}
+
+type PostUsersIdFollowResponseObject interface {
+	VisitPostUsersIdFollowResponse(w http.ResponseWriter) error
+}
+
+type PostUsersIdFollow201JSONResponse Follow
+
+func (response PostUsersIdFollow201JSONResponse) VisitPostUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdFollow400JSONResponse Error
+
+func (response PostUsersIdFollow400JSONResponse) VisitPostUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdFollow401JSONResponse Error
+
+func (response PostUsersIdFollow401JSONResponse) VisitPostUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdFollow404JSONResponse Error
+
+func (response PostUsersIdFollow404JSONResponse) VisitPostUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdFollow409JSONResponse Error
+
+func (response PostUsersIdFollow409JSONResponse) VisitPostUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdFollow500JSONResponse Error
+
+func (response PostUsersIdFollow500JSONResponse) VisitPostUsersIdFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowersRequestObject struct {
+	Id     UserId `json:"id"`
+	Params GetUsersIdFollowersParams
+}
+
+type GetUsersIdFollowersResponseObject interface {
+	VisitGetUsersIdFollowersResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdFollowers200JSONResponse FollowsPage
+
+func (response GetUsersIdFollowers200JSONResponse) VisitGetUsersIdFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowers400JSONResponse Error
+
+func (response GetUsersIdFollowers400JSONResponse) VisitGetUsersIdFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowers401JSONResponse Error
+
+func (response GetUsersIdFollowers401JSONResponse) VisitGetUsersIdFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowers404JSONResponse Error
+
+func (response GetUsersIdFollowers404JSONResponse) VisitGetUsersIdFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowers500JSONResponse Error
+
+func (response GetUsersIdFollowers500JSONResponse) VisitGetUsersIdFollowersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowingRequestObject struct {
+	Id     UserId `json:"id"`
+	Params GetUsersIdFollowingParams
+}
+
+type GetUsersIdFollowingResponseObject interface {
+	VisitGetUsersIdFollowingResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdFollowing200JSONResponse FollowsPage
+
+func (response GetUsersIdFollowing200JSONResponse) VisitGetUsersIdFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowing400JSONResponse Error
+
+func (response GetUsersIdFollowing400JSONResponse) VisitGetUsersIdFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowing401JSONResponse Error
+
+func (response GetUsersIdFollowing401JSONResponse) VisitGetUsersIdFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowing404JSONResponse Error
+
+func (response GetUsersIdFollowing404JSONResponse) VisitGetUsersIdFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdFollowing500JSONResponse Error
+
+func (response GetUsersIdFollowing500JSONResponse) VisitGetUsersIdFollowingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetUsersIdStatsRequestObject struct {
 	Id UserId `json:"id"`
 }
@@ -526,7 +1540,61 @@ func (response GetUsersIdStats401JSONResponse) VisitGetUsersIdStatsResponse(w ht
 
 type GetUsersIdStats500JSONResponse Error
 
-func (response GetUsersIdStats500JSONResponse) VisitGetUsersIdStatsResponse(w http.ResponseWriter) error {
+func (response GetUsersIdStats500JSONResponse) VisitGetUsersIdStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdXpRequestObject struct {
+	Id     UserId `json:"id"`
+	Params GetUsersIdXpParams
+}
+
+type GetUsersIdXpResponseObject interface {
+	VisitGetUsersIdXpResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdXp200JSONResponse XPLedgerPage
+
+func (response GetUsersIdXp200JSONResponse) VisitGetUsersIdXpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdXp400JSONResponse Error
+
+func (response GetUsersIdXp400JSONResponse) VisitGetUsersIdXpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdXp401JSONResponse Error
+
+func (response GetUsersIdXp401JSONResponse) VisitGetUsersIdXpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdXp403JSONResponse Error
+
+func (response GetUsersIdXp403JSONResponse) VisitGetUsersIdXpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdXp500JSONResponse Error
+
+func (response GetUsersIdXp500JSONResponse) VisitGetUsersIdXpResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -561,6 +1629,33 @@ func (response PutUsersReplacerIdStudentsStudentIdStatus400JSONResponse) VisitPu
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PutUsersReplacerIdStudentsStudentIdStatus401JSONResponse Error
+
+func (response PutUsersReplacerIdStudentsStudentIdStatus401JSONResponse) VisitPutUsersReplacerIdStudentsStudentIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersReplacerIdStudentsStudentIdStatus403JSONResponse Error
+
+func (response PutUsersReplacerIdStudentsStudentIdStatus403JSONResponse) VisitPutUsersReplacerIdStudentsStudentIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersReplacerIdStudentsStudentIdStatus404JSONResponse Error
+
+func (response PutUsersReplacerIdStudentsStudentIdStatus404JSONResponse) VisitPutUsersReplacerIdStudentsStudentIdStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PutUsersReplacerIdStudentsStudentIdStatus500JSONResponse Error
 
 func (response PutUsersReplacerIdStudentsStudentIdStatus500JSONResponse) VisitPutUsersReplacerIdStudentsStudentIdStatusResponse(w http.ResponseWriter) error {
@@ -572,6 +1667,30 @@ func (response PutUsersReplacerIdStudentsStudentIdStatus500JSONResponse) VisitPu
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Get the profile of the current user
+	// (GET /users/me)
+	GetUsersMe(ctx context.Context, request GetUsersMeRequestObject) (GetUsersMeResponseObject, error)
+	// Update the profile of the current user
+	// (PATCH /users/me)
+	PatchUsersMe(ctx context.Context, request PatchUsersMeRequestObject) (PatchUsersMeResponseObject, error)
+	// Get the achievements of the current user
+	// (GET /users/me/achievements)
+	GetUsersMeAchievements(ctx context.Context, request GetUsersMeAchievementsRequestObject) (GetUsersMeAchievementsResponseObject, error)
+	// Upload the avatar of the current user
+	// (PUT /users/me/avatar)
+	PutUsersMeAvatar(ctx context.Context, request PutUsersMeAvatarRequestObject) (PutUsersMeAvatarResponseObject, error)
+	// Get the badges of the current user
+	// (GET /users/me/badges)
+	GetUsersMeBadges(ctx context.Context, request GetUsersMeBadgesRequestObject) (GetUsersMeBadgesResponseObject, error)
+	// Change the password of the current user
+	// (PUT /users/me/password)
+	PutUsersMePassword(ctx context.Context, request PutUsersMePasswordRequestObject) (PutUsersMePasswordResponseObject, error)
+	// Get the statistics of the current user
+	// (GET /users/me/stats)
+	GetUsersMeStats(ctx context.Context, request GetUsersMeStatsRequestObject) (GetUsersMeStatsResponseObject, error)
+	// Upload a user avatar
+	// (PUT /users/profile/avatar/{id})
+	PutUsersProfileAvatarId(ctx context.Context, request PutUsersProfileAvatarIdRequestObject) (PutUsersProfileAvatarIdResponseObject, error)
 	// Update user password
 	// (PUT /users/profile/password/{id})
 	PutUsersProfilePasswordId(ctx context.Context, request PutUsersProfilePasswordIdRequestObject) (PutUsersProfilePasswordIdResponseObject, error)
@@ -587,9 +1706,24 @@ type StrictServerInterface interface {
 	// Get user badges
 	// (GET /users/{id}/badges)
 	GetUsersIdBadges(ctx context.Context, request GetUsersIdBadgesRequestObject) (GetUsersIdBadgesResponseObject, error)
+	// Unfollow a user
+	// (DELETE /users/{id}/follow)
+	DeleteUsersIdFollow(ctx context.Context, request DeleteUsersIdFollowRequestObject) (DeleteUsersIdFollowResponseObject, error)
+	// Follow a user
+	// (POST /users/{id}/follow)
+	PostUsersIdFollow(ctx context.Context, request PostUsersIdFollowRequestObject) (PostUsersIdFollowResponseObject, error)
+	// List the followers of a user
+	// (GET /users/{id}/followers)
+	GetUsersIdFollowers(ctx context.Context, request GetUsersIdFollowersRequestObject) (GetUsersIdFollowersResponseObject, error)
+	// List the users a user follows
+	// (GET /users/{id}/following)
+	GetUsersIdFollowing(ctx context.Context, request GetUsersIdFollowingRequestObject) (GetUsersIdFollowingResponseObject, error)
 	// Get user statistics
 	// (GET /users/{id}/stats)
 	GetUsersIdStats(ctx context.Context, request GetUsersIdStatsRequestObject) (GetUsersIdStatsResponseObject, error)
+	// List the XP history of a user
+	// (GET /users/{id}/xp)
+	GetUsersIdXp(ctx context.Context, request GetUsersIdXpRequestObject) (GetUsersIdXpResponseObject, error)
 	// Update student status
 	// (PUT /users/{replacer_id}/students/{student_id}/status)
 	PutUsersReplacerIdStudentsStudentIdStatus(ctx context.Context, request PutUsersReplacerIdStudentsStudentIdStatusRequestObject) (PutUsersReplacerIdStudentsStudentIdStatusResponseObject, error)
@@ -607,6 +1741,216 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// GetUsersMe operation middleware
+func (sh *strictHandler) GetUsersMe(ctx echo.Context) error {
+	var request GetUsersMeRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMe(ctx.Request().Context(), request.(GetUsersMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersMeResponseObject); ok {
+		return validResponse.VisitGetUsersMeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchUsersMe operation middleware
+func (sh *strictHandler) PatchUsersMe(ctx echo.Context) error {
+	var request PatchUsersMeRequestObject
+
+	var body PatchUsersMeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchUsersMe(ctx.Request().Context(), request.(PatchUsersMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchUsersMe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchUsersMeResponseObject); ok {
+		return validResponse.VisitPatchUsersMeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUsersMeAchievements operation middleware
+func (sh *strictHandler) GetUsersMeAchievements(ctx echo.Context) error {
+	var request GetUsersMeAchievementsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMeAchievements(ctx.Request().Context(), request.(GetUsersMeAchievementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMeAchievements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersMeAchievementsResponseObject); ok {
+		return validResponse.VisitGetUsersMeAchievementsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutUsersMeAvatar operation middleware
+func (sh *strictHandler) PutUsersMeAvatar(ctx echo.Context) error {
+	var request PutUsersMeAvatarRequestObject
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersMeAvatar(ctx.Request().Context(), request.(PutUsersMeAvatarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersMeAvatar")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutUsersMeAvatarResponseObject); ok {
+		return validResponse.VisitPutUsersMeAvatarResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUsersMeBadges operation middleware
+func (sh *strictHandler) GetUsersMeBadges(ctx echo.Context) error {
+	var request GetUsersMeBadgesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMeBadges(ctx.Request().Context(), request.(GetUsersMeBadgesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMeBadges")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersMeBadgesResponseObject); ok {
+		return validResponse.VisitGetUsersMeBadgesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutUsersMePassword operation middleware
+func (sh *strictHandler) PutUsersMePassword(ctx echo.Context) error {
+	var request PutUsersMePasswordRequestObject
+
+	var body PutUsersMePasswordJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersMePassword(ctx.Request().Context(), request.(PutUsersMePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersMePassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutUsersMePasswordResponseObject); ok {
+		return validResponse.VisitPutUsersMePasswordResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUsersMeStats operation middleware
+func (sh *strictHandler) GetUsersMeStats(ctx echo.Context) error {
+	var request GetUsersMeStatsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMeStats(ctx.Request().Context(), request.(GetUsersMeStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMeStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersMeStatsResponseObject); ok {
+		return validResponse.VisitGetUsersMeStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutUsersProfileAvatarId operation middleware
+func (sh *strictHandler) PutUsersProfileAvatarId(ctx echo.Context, id UserId) error {
+	var request PutUsersProfileAvatarIdRequestObject
+
+	request.Id = id
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersProfileAvatarId(ctx.Request().Context(), request.(PutUsersProfileAvatarIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersProfileAvatarId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutUsersProfileAvatarIdResponseObject); ok {
+		return validResponse.VisitPutUsersProfileAvatarIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PutUsersProfilePasswordId operation middleware
 func (sh *strictHandler) PutUsersProfilePasswordId(ctx echo.Context, id UserId) error {
 	var request PutUsersProfilePasswordIdRequestObject
@@ -744,6 +2088,108 @@ func (sh *strictHandler) GetUsersIdBadges(ctx echo.Context, id UserId) error {
 	return nil
 }
 
+// DeleteUsersIdFollow operation middleware
+func (sh *strictHandler) DeleteUsersIdFollow(ctx echo.Context, id UserId) error {
+	var request DeleteUsersIdFollowRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersIdFollow(ctx.Request().Context(), request.(DeleteUsersIdFollowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersIdFollow")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteUsersIdFollowResponseObject); ok {
+		return validResponse.VisitDeleteUsersIdFollowResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersIdFollow operation middleware
+func (sh *strictHandler) PostUsersIdFollow(ctx echo.Context, id UserId) error {
+	var request PostUsersIdFollowRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersIdFollow(ctx.Request().Context(), request.(PostUsersIdFollowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersIdFollow")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersIdFollowResponseObject); ok {
+		return validResponse.VisitPostUsersIdFollowResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUsersIdFollowers operation middleware
+func (sh *strictHandler) GetUsersIdFollowers(ctx echo.Context, id UserId, params GetUsersIdFollowersParams) error {
+	var request GetUsersIdFollowersRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersIdFollowers(ctx.Request().Context(), request.(GetUsersIdFollowersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersIdFollowers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersIdFollowersResponseObject); ok {
+		return validResponse.VisitGetUsersIdFollowersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUsersIdFollowing operation middleware
+func (sh *strictHandler) GetUsersIdFollowing(ctx echo.Context, id UserId, params GetUsersIdFollowingParams) error {
+	var request GetUsersIdFollowingRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersIdFollowing(ctx.Request().Context(), request.(GetUsersIdFollowingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersIdFollowing")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersIdFollowingResponseObject); ok {
+		return validResponse.VisitGetUsersIdFollowingResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetUsersIdStats operation middleware
 func (sh *strictHandler) GetUsersIdStats(ctx echo.Context, id UserId) error {
 	var request GetUsersIdStatsRequestObject
@@ -769,6 +2215,32 @@ func (sh *strictHandler) GetUsersIdStats(ctx echo.Context, id UserId) error {
 	return nil
 }
 
+// GetUsersIdXp operation middleware
+func (sh *strictHandler) GetUsersIdXp(ctx echo.Context, id UserId, params GetUsersIdXpParams) error {
+	var request GetUsersIdXpRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersIdXp(ctx.Request().Context(), request.(GetUsersIdXpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersIdXp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersIdXpResponseObject); ok {
+		return validResponse.VisitGetUsersIdXpResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PutUsersReplacerIdStudentsStudentIdStatus operation middleware
 func (sh *strictHandler) PutUsersReplacerIdStudentsStudentIdStatus(ctx echo.Context, replacerId ReplacerId, studentId StudentId) error {
 	var request PutUsersReplacerIdStudentsStudentIdStatusRequestObject
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_courses_tutor_id;
+
+ALTER TABLE courses DROP COLUMN IF EXISTS is_archived;
+ALTER TABLE courses DROP COLUMN IF EXISTS duration;
@@ -0,0 +1,5 @@
+-- Columns required for course authoring
+ALTER TABLE courses ADD COLUMN duration VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE courses ADD COLUMN is_archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_courses_tutor_id ON courses(tutor_id);
@@ -0,0 +1,25 @@
+ALTER TABLE courses ADD COLUMN student_id UUID;
+ALTER TABLE courses ADD COLUMN progress INT NOT NULL DEFAULT 0;
+ALTER TABLE courses ADD COLUMN completed_lessons INT NOT NULL DEFAULT 0;
+ALTER TABLE courses ADD COLUMN next_lesson_id UUID;
+
+-- A course row can only hold one student, keep the earliest enrollment
+UPDATE courses SET
+    student_id = e.student_id,
+    progress = e.progress,
+    completed_lessons = e.completed_lessons,
+    next_lesson_id = e.next_lesson_id
+FROM (
+    SELECT DISTINCT ON (course_id) course_id, student_id, progress, completed_lessons, next_lesson_id
+    FROM enrollments
+    ORDER BY course_id, created_at
+) e
+WHERE e.course_id = courses.id;
+
+ALTER TABLE courses ADD CONSTRAINT fk_courses_student
+    FOREIGN KEY (student_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE courses ADD CONSTRAINT fk_courses_next_lesson
+    FOREIGN KEY (next_lesson_id) REFERENCES lessons(id) ON DELETE SET NULL;
+CREATE INDEX idx_courses_next_lesson_id ON courses(next_lesson_id);
+
+DROP TABLE IF EXISTS enrollments;
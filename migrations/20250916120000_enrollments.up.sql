@@ -0,0 +1,31 @@
+CREATE TABLE enrollments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    student_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    progress INT NOT NULL DEFAULT 0,
+    completed_lessons INT NOT NULL DEFAULT 0,
+    next_lesson_id UUID REFERENCES lessons(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_enrollments_course_student UNIQUE (course_id, student_id)
+);
+
+CREATE INDEX idx_enrollments_student_id ON enrollments(student_id);
+
+-- Move per-student state off the courses rows
+INSERT INTO enrollments (course_id, student_id, progress, completed_lessons, next_lesson_id)
+SELECT id, student_id, progress, completed_lessons, next_lesson_id
+FROM courses
+WHERE student_id IS NOT NULL;
+
+UPDATE courses SET students_count = (
+    SELECT COUNT(*) FROM enrollments WHERE enrollments.course_id = courses.id
+);
+
+DROP INDEX IF EXISTS idx_courses_next_lesson_id;
+ALTER TABLE courses DROP CONSTRAINT IF EXISTS fk_courses_next_lesson;
+ALTER TABLE courses DROP CONSTRAINT IF EXISTS fk_courses_student;
+ALTER TABLE courses DROP COLUMN next_lesson_id;
+ALTER TABLE courses DROP COLUMN completed_lessons;
+ALTER TABLE courses DROP COLUMN progress;
+ALTER TABLE courses DROP COLUMN student_id;
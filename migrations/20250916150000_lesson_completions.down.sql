@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_lesson_completions_lesson_id;
+DROP TABLE IF EXISTS lesson_completions;
@@ -0,0 +1,9 @@
+CREATE TABLE lesson_completions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    enrollment_id UUID NOT NULL REFERENCES enrollments(id) ON DELETE CASCADE,
+    lesson_id UUID NOT NULL REFERENCES lessons(id) ON DELETE CASCADE,
+    completed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_lesson_completions_enrollment_lesson UNIQUE (enrollment_id, lesson_id)
+);
+
+CREATE INDEX idx_lesson_completions_lesson_id ON lesson_completions(lesson_id);
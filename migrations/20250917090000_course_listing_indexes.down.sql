@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_courses_created_at;
+DROP INDEX IF EXISTS idx_courses_students_count;
+DROP INDEX IF EXISTS idx_courses_rating;
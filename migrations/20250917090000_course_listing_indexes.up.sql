@@ -0,0 +1,4 @@
+-- Indexes for sorting the course catalogue
+CREATE INDEX idx_courses_rating ON courses(rating);
+CREATE INDEX idx_courses_students_count ON courses(students_count);
+CREATE INDEX idx_courses_created_at ON courses(created_at);
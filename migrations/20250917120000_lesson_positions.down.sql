@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_lessons_course_position;
+ALTER TABLE lessons DROP COLUMN IF EXISTS position;
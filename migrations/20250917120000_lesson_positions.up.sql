@@ -0,0 +1,16 @@
+ALTER TABLE lessons ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+-- Existing lessons keep their creation order
+UPDATE lessons SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY course_id ORDER BY created_at, id) AS position
+    FROM lessons
+) AS ordered
+WHERE lessons.id = ordered.id;
+
+CREATE INDEX idx_lessons_course_position ON lessons(course_id, position);
+
+-- Bring the cached lesson counts in line with the lessons table
+UPDATE courses SET total_lessons = (
+    SELECT COUNT(*) FROM lessons WHERE lessons.course_id = courses.id
+);
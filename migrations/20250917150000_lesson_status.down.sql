@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_lessons_course_status;
+ALTER TABLE lessons DROP CONSTRAINT IF EXISTS chk_lessons_status;
+ALTER TABLE lessons DROP COLUMN IF EXISTS status;
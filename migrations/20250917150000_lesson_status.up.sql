@@ -0,0 +1,7 @@
+-- Lessons that already exist were visible to everyone, so they start out published
+ALTER TABLE lessons ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'published';
+ALTER TABLE lessons ALTER COLUMN status SET DEFAULT 'draft';
+ALTER TABLE lessons ADD CONSTRAINT chk_lessons_status
+    CHECK (status IN ('draft', 'published', 'archived'));
+
+CREATE INDEX idx_lessons_course_status ON lessons(course_id, status);
@@ -0,0 +1,24 @@
+-- Failed login counters per account (lowercased email) and per client IP
+CREATE TABLE login_throttles (
+    scope VARCHAR(16) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,
+    PRIMARY KEY (scope, key)
+);
+
+-- Lockouts caused by repeated failed logins, listed to administrators
+CREATE TABLE lockout_events (
+    id UUID PRIMARY KEY,
+    scope VARCHAR(16) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    failures INTEGER NOT NULL,
+    locked_until TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_lockout_events_created_at ON lockout_events(created_at DESC);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS role_changes;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_role;
@@ -0,0 +1,17 @@
+-- Only known roles can be stored
+ALTER TABLE users ADD CONSTRAINT chk_users_role
+    CHECK (role IN ('student', 'tutor', 'admin'));
+
+-- Audit log of admins promoting or demoting users
+CREATE TABLE role_changes (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_by UUID NOT NULL REFERENCES users(id),
+    old_role VARCHAR(32) NOT NULL,
+    new_role VARCHAR(32) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_role_changes_user_id ON role_changes(user_id, created_at DESC);
+CREATE INDEX idx_role_changes_created_at ON role_changes(created_at DESC);
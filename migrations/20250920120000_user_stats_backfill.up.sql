@@ -0,0 +1,7 @@
+-- Accounts registered before stats were created with the user have none yet
+INSERT INTO user_stats (user_id)
+SELECT u.id FROM users u
+WHERE NOT EXISTS (SELECT 1 FROM user_stats s WHERE s.user_id = u.id);
+
+-- Every user has exactly one stats row
+CREATE UNIQUE INDEX idx_user_stats_user_id ON user_stats(user_id);
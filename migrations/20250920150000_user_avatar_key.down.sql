@@ -0,0 +1 @@
+ALTER TABLE user_infos DROP COLUMN IF EXISTS avatar_key;
@@ -0,0 +1,2 @@
+-- Blob store prefix of the avatar variants, used to remove them when the avatar is replaced
+ALTER TABLE user_infos ADD COLUMN avatar_key VARCHAR(255) NOT NULL DEFAULT '';
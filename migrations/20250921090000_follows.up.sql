@@ -0,0 +1,14 @@
+-- Users following other users
+CREATE TABLE follows (
+    follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT chk_follows_not_self CHECK (follower_id <> followee_id)
+);
+
+-- Follower lists, the primary key covers following lists
+CREATE INDEX idx_follows_followee_id ON follows(followee_id, created_at DESC);
+
+-- Counters were never maintained before, start them from the follows table
+UPDATE user_stats SET followers = 0, following = 0;
@@ -0,0 +1,13 @@
+-- XP awarded to users, the XP of user_stats is the sum of a user's entries
+CREATE TABLE xp_ledger (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_type VARCHAR(64) NOT NULL,
+    subject_id UUID NOT NULL,
+    points INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- An event is rewarded at most once, even when it is published again
+CREATE UNIQUE INDEX idx_xp_ledger_event ON xp_ledger(user_id, event_type, subject_id);
+CREATE INDEX idx_xp_ledger_user_id ON xp_ledger(user_id, created_at DESC);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_user_badges_definition;
+ALTER TABLE user_badges DROP COLUMN IF EXISTS badge_id;
+
+DROP INDEX IF EXISTS idx_user_achievements_definition;
+ALTER TABLE user_achievements DROP COLUMN IF EXISTS achievement_id;
+
+DROP TABLE IF EXISTS badge_definitions;
+DROP TABLE IF EXISTS achievement_definitions;
@@ -0,0 +1,37 @@
+-- Catalogs of achievements and badges, granted when a metric of the user reaches the threshold
+CREATE TABLE achievement_definitions (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    icon VARCHAR(255) NOT NULL DEFAULT '',
+    metric VARCHAR(64) NOT NULL,
+    threshold INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_achievement_definitions_metric
+        CHECK (metric IN ('lessons_completed', 'courses_completed', 'xp', 'level', 'followers')),
+    CONSTRAINT chk_achievement_definitions_threshold CHECK (threshold > 0)
+);
+
+CREATE TABLE badge_definitions (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    icon VARCHAR(255) NOT NULL DEFAULT '',
+    metric VARCHAR(64) NOT NULL,
+    threshold INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_badge_definitions_metric
+        CHECK (metric IN ('lessons_completed', 'courses_completed', 'xp', 'level', 'followers')),
+    CONSTRAINT chk_badge_definitions_threshold CHECK (threshold > 0)
+);
+
+-- Grants reference their definition, a definition is granted to a user at most once
+ALTER TABLE user_achievements
+    ADD COLUMN achievement_id UUID REFERENCES achievement_definitions(id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX idx_user_achievements_definition ON user_achievements(user_id, achievement_id);
+
+ALTER TABLE user_badges
+    ADD COLUMN badge_id UUID REFERENCES badge_definitions(id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX idx_user_badges_definition ON user_badges(user_id, badge_id);
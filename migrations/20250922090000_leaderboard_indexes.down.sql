@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_xp_ledger_created_at;
+DROP INDEX IF EXISTS idx_user_stats_xp;
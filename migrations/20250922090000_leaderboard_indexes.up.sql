@@ -0,0 +1,5 @@
+-- All time leaderboard ranks user_stats by XP
+CREATE INDEX idx_user_stats_xp ON user_stats(xp DESC) WHERE xp > 0;
+
+-- Weekly and monthly leaderboards sum the ledger entries of the period
+CREATE INDEX idx_xp_ledger_created_at ON xp_ledger(created_at, user_id);